@@ -0,0 +1,158 @@
+package alertmanager
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+)
+
+// generatorLabel 대시보드가 보낸 알림 표시 (webhook으로 되돌아온 알림을 다시 이벤트로 만들지 않기 위해 사용)
+const (
+	generatorLabel = "generator"
+	generatorValue = "pf-dashboard"
+)
+
+// Alert Alertmanager API v2 알림 (POST /api/v2/alerts)
+type Alert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"` // 미래면 firing, 지났으면 resolved
+	GeneratorURL string            `json:"generatorURL,omitempty"`
+}
+
+// WebhookMessage Alertmanager webhook 본문 (version 4)
+type WebhookMessage struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	Status            string            `json:"status"` // firing, resolved
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []WebhookAlert    `json:"alerts"`
+}
+
+// WebhookAlert webhook 본문의 개별 알림
+type WebhookAlert struct {
+	Status       string            `json:"status"` // firing, resolved
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// IsOwn 대시보드가 Alertmanager로 보낸 알림인지
+func (a WebhookAlert) IsOwn() bool {
+	return a.Labels[generatorLabel] == generatorValue
+}
+
+// EventFromAlert webhook 알림을 대시보드 이벤트로 변환
+// clusterID는 ClusterResolver로 찾은 클러스터 (없으면 빈 문자열)
+func EventFromAlert(a WebhookAlert, clusterID string) eventlog.Event {
+	name := a.Labels["alertname"]
+	if name == "" {
+		name = "unnamed"
+	}
+
+	summary := a.Annotations["summary"]
+	if summary == "" {
+		summary = a.Annotations["description"]
+	}
+
+	attributes := map[string]string{
+		"fingerprint": a.Fingerprint,
+		"status":      a.Status,
+		"startsAt":    a.StartsAt.Format(time.RFC3339),
+	}
+	if a.GeneratorURL != "" {
+		attributes["generatorURL"] = a.GeneratorURL
+	}
+	for k, v := range a.Labels {
+		attributes["label."+k] = v
+	}
+
+	event := eventlog.Event{
+		Source:         "alertmanager",
+		ClusterID:      clusterID,
+		InvolvedObject: &eventlog.ObjectReference{Kind: "Alert", Namespace: a.Labels["namespace"], Name: name},
+		Attributes:     attributes,
+	}
+
+	if a.Status == "resolved" {
+		event.Type = "success"
+		event.Severity = eventlog.SeverityInfo
+		event.Message = strings.TrimSuffix(fmt.Sprintf("✅ [Alertmanager] %s resolved: %s", name, summary), ": ")
+		attributes["endsAt"] = a.EndsAt.Format(time.RFC3339)
+		return event
+	}
+
+	// 심각도 라벨: critical, warning (그 외는 info)
+	switch strings.ToLower(a.Labels["severity"]) {
+	case eventlog.SeverityCritical:
+		event.Type = "critical"
+		event.Severity = eventlog.SeverityCritical
+	case eventlog.SeverityWarning:
+		event.Type = "critical"
+		event.Severity = eventlog.SeverityWarning
+	default:
+		event.Type = "info"
+		event.Severity = eventlog.SeverityInfo
+	}
+	event.Message = strings.TrimSuffix(fmt.Sprintf("🔔 [Alertmanager] %s: %s", name, summary), ": ")
+	return event
+}
+
+// alertFromEvent critical 이벤트를 Alertmanager 알림으로 변환
+// 같은 리소스의 firing/resolved가 같은 알림이 되도록 라벨은 리소스 정보로만 구성
+func alertFromEvent(event eventlog.Event, obj eventlog.ObjectReference, extraLabels map[string]string) *Alert {
+	labels := map[string]string{
+		"alertname":    "PFDashboard" + obj.Kind + "Failure",
+		"severity":     event.Severity,
+		"kind":         obj.Kind,
+		"name":         obj.Name,
+		generatorLabel: generatorValue,
+	}
+	if event.ClusterID != "" {
+		labels["cluster"] = event.ClusterID
+	}
+	if obj.Namespace != "" {
+		labels["namespace"] = obj.Namespace
+	}
+	if event.Source != "" {
+		labels["source"] = event.Source
+	}
+	for k, v := range extraLabels {
+		labels[k] = v
+	}
+
+	annotations := map[string]string{
+		"summary": event.Message,
+		"eventId": fmt.Sprintf("%d", event.ID),
+	}
+	if len(event.Attributes) > 0 {
+		keys := make([]string, 0, len(event.Attributes))
+		for k := range event.Attributes {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		parts := make([]string, 0, len(keys))
+		for _, k := range keys {
+			parts = append(parts, k+"="+event.Attributes[k])
+		}
+		annotations["description"] = strings.Join(parts, ", ")
+	}
+
+	return &Alert{
+		Labels:      labels,
+		Annotations: annotations,
+		StartsAt:    event.Time,
+	}
+}
@@ -0,0 +1,78 @@
+package alertmanager
+
+import (
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/monitor"
+)
+
+// ClusterResolver 알림 라벨에서 대시보드 클러스터 ID 찾기
+type ClusterResolver struct {
+	labels  []string          // 클러스터를 나타내는 라벨 이름 (앞에 있을수록 우선)
+	mapping map[string]string // 라벨 값 → 클러스터 ID (소문자 키)
+}
+
+// NewClusterResolverFromEnv 환경변수 기반 ClusterResolver 생성
+// ALERTMANAGER_CLUSTER_LABELS: 클러스터 라벨 이름 (기본 cluster,cluster_id,karmada_cluster)
+// ALERTMANAGER_CLUSTER_MAP: "prod-seoul=member1,prod-busan=member2" 형식의 라벨 값 매핑
+func NewClusterResolverFromEnv() *ClusterResolver {
+	labels := splitList(os.Getenv("ALERTMANAGER_CLUSTER_LABELS"))
+	if len(labels) == 0 {
+		labels = []string{"cluster", "cluster_id", "karmada_cluster"}
+	}
+
+	// kubeconfig context 이름은 기본으로 매핑
+	mapping := map[string]string{
+		strings.ToLower(monitor.Member1ContextName): "member1",
+		strings.ToLower(monitor.Member2ContextName): "member2",
+	}
+	for value, clusterID := range parseLabels(os.Getenv("ALERTMANAGER_CLUSTER_MAP")) {
+		mapping[strings.ToLower(value)] = clusterID
+	}
+
+	return &ClusterResolver{labels: labels, mapping: mapping}
+}
+
+// Resolve 라벨에 해당하는 클러스터 ID (매핑이 없으면 라벨 값, 라벨이 없으면 빈 문자열)
+func (r *ClusterResolver) Resolve(labels map[string]string) string {
+	for _, name := range r.labels {
+		value := labels[name]
+		if value == "" {
+			continue
+		}
+		if clusterID, ok := r.mapping[strings.ToLower(value)]; ok {
+			return clusterID
+		}
+		return value
+	}
+	return ""
+}
+
+// getDurationEnv 기간 환경변수 조회
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Warning: invalid %s %q, using %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return d
+}
+
+// splitList 쉼표로 구분된 환경변수 값 파싱
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
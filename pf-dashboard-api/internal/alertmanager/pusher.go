@@ -0,0 +1,247 @@
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+)
+
+// PushStatus Alertmanager 전송 현황
+type PushStatus struct {
+	Enabled        bool       `json:"enabled"`
+	URLs           []string   `json:"urls"`
+	ResendInterval string     `json:"resendInterval"`
+	Firing         []Alert    `json:"firing"`
+	LastPushAt     *time.Time `json:"lastPushAt,omitempty"`
+	LastError      string     `json:"lastError,omitempty"`
+}
+
+// actionSources 상태 신호가 아닌 조치 이벤트 소스 (같은 리소스의 복구 이벤트가 오지 않음)
+var actionSources = map[string]bool{
+	"failover":        true,
+	"gslb-reconciler": true,
+}
+
+// Pusher critical 이벤트를 Alertmanager 알림으로 전송하고, 같은 리소스가 복구되면 resolve
+type Pusher struct {
+	urls           []string
+	client         *http.Client
+	labels         map[string]string // 모든 알림에 추가할 라벨
+	generatorURL   string
+	resendInterval time.Duration
+
+	mu         sync.Mutex
+	firing     map[string]*Alert // 리소스별 firing 알림
+	lastPushAt *time.Time
+	lastError  string
+}
+
+// NewPusherFromEnv 환경변수 기반 Pusher 생성
+// ALERTMANAGER_URL은 쉼표로 여러 인스턴스 지정 가능 (모두에게 전송)
+func NewPusherFromEnv() *Pusher {
+	return &Pusher{
+		urls:           splitList(os.Getenv("ALERTMANAGER_URL")),
+		client:         &http.Client{Timeout: getDurationEnv("ALERTMANAGER_TIMEOUT", 10*time.Second)},
+		labels:         parseLabels(os.Getenv("ALERTMANAGER_LABELS")),
+		generatorURL:   os.Getenv("DASHBOARD_URL"),
+		resendInterval: getDurationEnv("ALERTMANAGER_RESEND_INTERVAL", time.Minute),
+		firing:         make(map[string]*Alert),
+	}
+}
+
+// Enabled Alertmanager 주소가 설정되었는지
+func (p *Pusher) Enabled() bool {
+	return len(p.urls) > 0
+}
+
+// Run 이벤트 로그 구독 채널을 받아 알림 전송 (블로킹)
+// firing 알림은 resendInterval마다 다시 보내 Alertmanager에서 만료되지 않게 유지
+func (p *Pusher) Run(events <-chan eventlog.Event) {
+	if !p.Enabled() {
+		log.Printf("[Alertmanager] Push disabled (ALERTMANAGER_URL not set)")
+		return
+	}
+
+	log.Printf("[Alertmanager] Pushing critical events to %s (resend: %s)", strings.Join(p.urls, ", "), p.resendInterval)
+
+	ticker := time.NewTicker(p.resendInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			p.handle(event)
+		case <-ticker.C:
+			p.resend()
+		}
+	}
+}
+
+// handle 이벤트 하나를 알림으로 반영
+func (p *Pusher) handle(event eventlog.Event) {
+	// Alertmanager에서 받은 이벤트는 다시 보내지 않음
+	if event.Source == "alertmanager" {
+		return
+	}
+
+	obj := objectOf(event)
+	key := alertKey(event.ClusterID, obj)
+
+	p.mu.Lock()
+	var alert *Alert
+	switch {
+	case event.Severity == eventlog.SeverityCritical && actionSources[event.Source]:
+		// 복구 이벤트가 없는 조치 실패는 추적하지 않고 endsAt 만료로 resolve
+		alert = alertFromEvent(event, obj, p.labels)
+		alert.GeneratorURL = p.generatorURL
+		alert.EndsAt = time.Now().Add(3 * p.resendInterval)
+	case event.Severity == eventlog.SeverityCritical && p.firing[key] == nil:
+		// 집계 갱신(count > 1)이라도 resolve된 뒤 다시 발생했으면 새로 전송
+		// 이미 firing 중인 리소스는 resend가 유지
+		alert = alertFromEvent(event, obj, p.labels)
+		alert.GeneratorURL = p.generatorURL
+		alert.EndsAt = time.Now().Add(3 * p.resendInterval)
+		p.firing[key] = alert
+	case event.Type == "success" && p.firing[key] != nil:
+		alert = p.firing[key]
+		alert.EndsAt = time.Now()
+		delete(p.firing, key)
+	}
+	var batch []Alert
+	if alert != nil {
+		batch = []Alert{*alert}
+	}
+	p.mu.Unlock()
+
+	if batch != nil {
+		p.push(batch)
+	}
+}
+
+// resend firing 알림 다시 전송
+func (p *Pusher) resend() {
+	p.mu.Lock()
+	batch := make([]Alert, 0, len(p.firing))
+	endsAt := time.Now().Add(3 * p.resendInterval)
+	for _, alert := range p.firing {
+		alert.EndsAt = endsAt
+		batch = append(batch, *alert)
+	}
+	p.mu.Unlock()
+
+	if len(batch) > 0 {
+		p.push(batch)
+	}
+}
+
+// push 모든 Alertmanager 인스턴스에 알림 전송
+// 실패한 firing 알림은 다음 resend에서, resolve는 endsAt 만료로 Alertmanager가 정리
+func (p *Pusher) push(alerts []Alert) {
+	body, err := json.Marshal(alerts)
+	if err != nil {
+		log.Printf("[Alertmanager] Failed to encode alerts: %v", err)
+		return
+	}
+
+	var errs []string
+	for _, base := range p.urls {
+		if err := p.post(base, body); err != nil {
+			log.Printf("[Alertmanager] Failed to push %d alerts to %s: %v", len(alerts), base, err)
+			errs = append(errs, err.Error())
+		}
+	}
+
+	now := time.Now()
+	p.mu.Lock()
+	p.lastPushAt = &now
+	p.lastError = strings.Join(errs, "; ")
+	p.mu.Unlock()
+}
+
+// post Alertmanager API v2 알림 전송 1회
+func (p *Pusher) post(base string, body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.client.Timeout)
+	defer cancel()
+
+	url := strings.TrimSuffix(base, "/") + "/api/v2/alerts"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("alertmanager responded %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// Status 전송 현황 조회
+func (p *Pusher) Status() PushStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status := PushStatus{
+		Enabled:        p.Enabled(),
+		URLs:           p.urls,
+		ResendInterval: p.resendInterval.String(),
+		Firing:         make([]Alert, 0, len(p.firing)),
+		LastPushAt:     p.lastPushAt,
+		LastError:      p.lastError,
+	}
+	for _, alert := range p.firing {
+		status.Firing = append(status.Firing, *alert)
+	}
+	return status
+}
+
+// objectOf 알림 대상 리소스 (리소스 정보가 없으면 클러스터, 둘 다 없으면 소스)
+func objectOf(event eventlog.Event) eventlog.ObjectReference {
+	switch {
+	case event.InvolvedObject != nil:
+		return *event.InvolvedObject
+	case event.ClusterID != "":
+		return eventlog.ObjectReference{Kind: "Cluster", Name: event.ClusterID}
+	default:
+		return eventlog.ObjectReference{Kind: "Component", Name: event.Source}
+	}
+}
+
+// alertKey 리소스 식별 키
+func alertKey(clusterID string, obj eventlog.ObjectReference) string {
+	return strings.Join([]string{clusterID, obj.Kind, obj.Namespace, obj.Name}, "/")
+}
+
+// parseLabels "env=prod,team=platform" 형식 파싱
+func parseLabels(value string) map[string]string {
+	labels := make(map[string]string)
+	for _, item := range splitList(value) {
+		k, v, ok := strings.Cut(item, "=")
+		if !ok {
+			log.Printf("Warning: invalid label %q (expected key=value)", item)
+			continue
+		}
+		labels[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return labels
+}
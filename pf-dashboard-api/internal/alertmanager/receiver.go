@@ -0,0 +1,75 @@
+package alertmanager
+
+import (
+	"crypto/subtle"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+)
+
+// ErrWebhookDisabled webhook 토큰이 설정되지 않았고 인증 없는 수신도 허용하지 않음
+var ErrWebhookDisabled = errors.New("alertmanager webhook disabled (ALERTMANAGER_WEBHOOK_TOKEN not set)")
+
+// Receiver Alertmanager webhook 알림을 대시보드 이벤트로 기록
+type Receiver struct {
+	eventLog  *eventlog.EventLog
+	resolver  *ClusterResolver
+	token     string // "Authorization: Bearer <token>" 요청만 허용
+	anonymous bool   // 토큰 없이 모든 요청 허용 (명시적으로 설정한 경우만)
+}
+
+// NewReceiverFromEnv 환경변수 기반 Receiver 생성
+// ALERTMANAGER_WEBHOOK_TOKEN이 없으면 webhook을 거부하고, ALERTMANAGER_WEBHOOK_ALLOW_ANONYMOUS=true일 때만 인증 없이 허용
+func NewReceiverFromEnv(eventLog *eventlog.EventLog) *Receiver {
+	r := &Receiver{
+		eventLog:  eventLog,
+		resolver:  NewClusterResolverFromEnv(),
+		token:     os.Getenv("ALERTMANAGER_WEBHOOK_TOKEN"),
+		anonymous: os.Getenv("ALERTMANAGER_WEBHOOK_ALLOW_ANONYMOUS") == "true",
+	}
+	switch {
+	case r.token != "":
+	case r.anonymous:
+		log.Printf("Warning: [Alertmanager] Webhook accepts unauthenticated requests (ALERTMANAGER_WEBHOOK_ALLOW_ANONYMOUS=true)")
+	default:
+		log.Printf("[Alertmanager] Webhook disabled (ALERTMANAGER_WEBHOOK_TOKEN not set)")
+	}
+	return r
+}
+
+// Enabled 토큰이 설정되었거나 인증 없는 수신을 허용했는지
+func (r *Receiver) Enabled() bool {
+	return r.token != "" || r.anonymous
+}
+
+// Authorize webhook 요청 인증
+func (r *Receiver) Authorize(req *http.Request) bool {
+	if r.token == "" {
+		return r.anonymous
+	}
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(token), []byte(r.token)) == 1
+}
+
+// Receive webhook 본문의 알림을 이벤트로 기록하고 기록한 수 반환
+// 대시보드가 보낸 알림(generator=pf-dashboard)은 되돌아온 것이므로 무시
+func (r *Receiver) Receive(msg WebhookMessage) int {
+	recorded := 0
+	for _, alert := range msg.Alerts {
+		if alert.IsOwn() {
+			continue
+		}
+
+		clusterID := r.resolver.Resolve(alert.Labels)
+		event := EventFromAlert(alert, clusterID)
+		r.eventLog.Record(event)
+		recorded++
+	}
+
+	log.Printf("[Alertmanager] Received %d alerts from receiver %q (%s), recorded %d", len(msg.Alerts), msg.Receiver, msg.Status, recorded)
+	return recorded
+}
@@ -0,0 +1,252 @@
+package eventlog
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Aggregation 이벤트 중복 제거와 flapping 억제 설정
+type Aggregation struct {
+	DedupWindow   time.Duration // 같은 이벤트를 하나로 합치는 기간 (0이면 비활성화)
+	FlapWindow    time.Duration // 상태 전환 횟수를 세는 기간
+	FlapThreshold int           // FlapWindow 안의 상태 전환이 이 횟수 이상이면 flapping (0이면 비활성화)
+	StablePeriod  time.Duration // 상태 전환이 이 기간 동안 없으면 안정화로 판단
+}
+
+// flapState 리소스 하나의 상태 전환 기록
+type flapState struct {
+	transitions []time.Time // FlapWindow 안의 전환 시각
+	flapping    bool
+	event       Event       // flapping 이벤트 (억제한 전환마다 횟수 갱신)
+	last        Event       // 마지막으로 억제한 전환 이벤트
+	suppressed  int         // 억제한 전환 수
+	timer       *time.Timer // 안정화 판단 타이머
+}
+
+// AggregationFromEnv 환경변수 기반 집계 설정
+func AggregationFromEnv() Aggregation {
+	aggregation := Aggregation{
+		DedupWindow:   getDurationEnv("EVENT_DEDUP_WINDOW", 5*time.Minute),
+		FlapWindow:    getDurationEnv("EVENT_FLAP_WINDOW", 2*time.Minute),
+		FlapThreshold: getIntEnv("EVENT_FLAP_THRESHOLD", 4),
+		StablePeriod:  getDurationEnv("EVENT_FLAP_STABLE_PERIOD", 2*time.Minute),
+	}
+
+	log.Printf("[EventLog] Aggregation: dedup window %s, flapping %d changes in %s (stable after %s)",
+		aggregation.DedupWindow, aggregation.FlapThreshold, aggregation.FlapWindow, aggregation.StablePeriod)
+	return aggregation
+}
+
+// mergeDuplicate 중복 제거 기간 안에 같은 이벤트가 있으면 횟수를 늘리고 true 반환
+func (el *EventLog) mergeDuplicate(event Event) (Event, bool) {
+	if el.aggregation.DedupWindow <= 0 {
+		return Event{}, false
+	}
+
+	key := dedupKey(event)
+	existing, ok := el.recent[key]
+	if !ok || event.Time.Sub(existing.LastSeen) > el.aggregation.DedupWindow {
+		return Event{}, false
+	}
+
+	merged := *existing
+	merged.Count++
+	merged.LastSeen = event.Time
+	merged.Attributes = event.Attributes
+	if err := el.update(merged); err != nil {
+		// 보관 정책으로 삭제되었으면 새 이벤트로 기록
+		delete(el.recent, key)
+		return Event{}, false
+	}
+
+	*existing = merged
+	return merged, true
+}
+
+// forgetPreviousState 상태 전환 이벤트면 같은 리소스의 다른 이벤트를 중복 제거 대상에서 제외
+// DOWN → RECOVERED → DOWN처럼 상태가 바뀐 뒤 다시 발생한 이벤트는 이전 이벤트에 합치지 않고 새로 기록
+func (el *EventLog) forgetPreviousState(event Event) {
+	if el.aggregation.DedupWindow <= 0 || !isTransition(event) {
+		return
+	}
+
+	object, key := objectKey(event), dedupKey(event)
+	for k, e := range el.recent {
+		if e.InvolvedObject != nil && k != key && objectKey(*e) == object {
+			delete(el.recent, k)
+		}
+	}
+}
+
+// remember 새로 저장한 이벤트를 중복 제거 대상으로 등록하고 기간이 지난 항목 정리
+func (el *EventLog) remember(event Event) {
+	if el.aggregation.DedupWindow <= 0 || event.ID == 0 {
+		return
+	}
+
+	for key, e := range el.recent {
+		if event.Time.Sub(e.LastSeen) > el.aggregation.DedupWindow {
+			delete(el.recent, key)
+		}
+	}
+	el.recent[dedupKey(event)] = &event
+}
+
+// trackTransition 리소스 상태 전환 기록
+// flapping으로 판단되거나 이미 flapping 중이면 전환 이벤트 대신 flapping 이벤트를 갱신하고 true 반환
+func (el *EventLog) trackTransition(event Event) (Event, bool) {
+	if el.aggregation.FlapThreshold <= 0 || !isTransition(event) {
+		return Event{}, false
+	}
+
+	key := objectKey(event)
+	state, ok := el.flaps[key]
+	if !ok {
+		state = &flapState{}
+		el.flaps[key] = state
+	}
+
+	// FlapWindow 안의 전환만 유지
+	transitions := state.transitions[:0]
+	for _, t := range state.transitions {
+		if event.Time.Sub(t) <= el.aggregation.FlapWindow {
+			transitions = append(transitions, t)
+		}
+	}
+	state.transitions = append(transitions, event.Time)
+
+	if state.flapping {
+		state.last = event
+		state.suppressed++
+		state.timer.Reset(el.aggregation.StablePeriod)
+
+		merged := state.event
+		merged.Count++
+		merged.LastSeen = event.Time
+		merged.Attributes = flapAttributes(event, state.suppressed)
+		merged.Attributes["reason"] = "Flapping"
+		if err := el.update(merged); err != nil {
+			// 보관 정책으로 삭제되었으면 flapping 이벤트를 다시 기록
+			merged = el.append(flappingEvent(event, state.suppressed, el.aggregation.FlapWindow))
+		}
+		state.event = merged
+		return merged, true
+	}
+
+	if len(state.transitions) < el.aggregation.FlapThreshold {
+		return Event{}, false
+	}
+
+	// flapping 시작: 이후 전환은 안정화될 때까지 억제
+	state.flapping = true
+	state.last = event
+	state.suppressed = 1
+	state.event = el.append(flappingEvent(event, len(state.transitions), el.aggregation.FlapWindow))
+	state.timer = time.AfterFunc(el.aggregation.StablePeriod, func() { el.endFlapping(key) })
+
+	log.Printf("[EventLog] %s", state.event.Message)
+	return state.event, true
+}
+
+// pruneFlaps FlapWindow가 지나 더 이상 flapping 판단에 쓰이지 않는 전환 기록 삭제
+// flapping 중인 리소스는 endFlapping이 정리
+func (el *EventLog) pruneFlaps(now time.Time) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+
+	for key, state := range el.flaps {
+		if state.flapping {
+			continue
+		}
+		if len(state.transitions) == 0 || now.Sub(state.transitions[len(state.transitions)-1]) > el.aggregation.FlapWindow {
+			delete(el.flaps, key)
+		}
+	}
+}
+
+// endFlapping 안정화된 리소스의 최종 상태를 이벤트로 기록
+func (el *EventLog) endFlapping(key string) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+
+	state, ok := el.flaps[key]
+	if !ok || !state.flapping {
+		return
+	}
+
+	// 타이머 만료와 새 전환이 겹친 경우 (Reset으로 다시 예약됨)
+	lastTransition := state.transitions[len(state.transitions)-1]
+	if time.Since(lastTransition) < el.aggregation.StablePeriod {
+		return
+	}
+	delete(el.flaps, key)
+
+	final := state.last
+	final.Time = time.Time{}
+	final.Message = fmt.Sprintf("%s (stable after flapping, %d changes suppressed)", final.Message, state.suppressed)
+	final.Attributes = flapAttributes(state.last, state.suppressed)
+	final.Attributes["flapping"] = "resolved"
+	normalize(&final)
+
+	log.Printf("[EventLog] %s", final.Message)
+	el.append(final)
+}
+
+// flappingEvent flapping 시작 이벤트 생성
+func flappingEvent(event Event, changes int, window time.Duration) Event {
+	obj := event.InvolvedObject
+	message := fmt.Sprintf("⚠️ %s %s is FLAPPING (%d state changes in %s)", obj.Kind, obj.Name, changes, window)
+	if event.ClusterID != "" {
+		message = fmt.Sprintf("⚠️ %s %s in %s is FLAPPING (%d state changes in %s)", obj.Kind, obj.Name, event.ClusterID, changes, window)
+	}
+
+	flapping := Event{
+		Type:           "critical",
+		Severity:       SeverityWarning,
+		Message:        message,
+		Time:           event.Time,
+		Source:         event.Source,
+		ClusterID:      event.ClusterID,
+		InvolvedObject: obj,
+		Attributes:     flapAttributes(event, changes),
+	}
+	flapping.Attributes["reason"] = "Flapping"
+	normalize(&flapping)
+	return flapping
+}
+
+// flapAttributes 전환 이벤트 속성에 억제한 전환 수 추가
+func flapAttributes(event Event, changes int) map[string]string {
+	attributes := make(map[string]string, len(event.Attributes)+1)
+	for k, v := range event.Attributes {
+		attributes[k] = v
+	}
+	attributes["stateChanges"] = strconv.Itoa(changes)
+	return attributes
+}
+
+// isTransition 리소스 상태 전환 이벤트인지 (monitor의 Cluster/Node 상태 변화 등)
+func isTransition(event Event) bool {
+	return event.InvolvedObject != nil &&
+		event.Attributes["previousStatus"] != "" &&
+		event.Attributes["status"] != ""
+}
+
+// objectKey 리소스 식별 키
+// 외부에서 입력된 이벤트가 내부 리소스의 flapping 상태를 바꾸지 못하도록 Source 포함
+func objectKey(event Event) string {
+	obj := event.InvolvedObject
+	return strings.Join([]string{event.Source, event.ClusterID, obj.Kind, obj.Namespace, obj.Name}, "/")
+}
+
+// dedupKey 같은 이벤트로 볼 필드의 조합
+func dedupKey(event Event) string {
+	key := []string{event.Type, event.Severity, event.Source, event.ClusterID, event.Message}
+	if obj := event.InvolvedObject; obj != nil {
+		key = append(key, obj.Kind, obj.Namespace, obj.Name)
+	}
+	return strings.Join(key, "\x00")
+}
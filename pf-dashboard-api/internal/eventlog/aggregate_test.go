@@ -0,0 +1,76 @@
+package eventlog
+
+import (
+	"testing"
+	"time"
+)
+
+// nodeTransition monitor가 기록하는 노드 상태 전환 이벤트
+func nodeTransition(previous, current string, at time.Time) Event {
+	event := Event{
+		Type:           "critical",
+		Message:        "🔴 Node worker-1 in Member1 is now NOT READY",
+		Time:           at,
+		Source:         "monitor",
+		ClusterID:      "member1",
+		InvolvedObject: &ObjectReference{Kind: "Node", Name: "worker-1"},
+		Attributes:     map[string]string{"previousStatus": previous, "status": current},
+	}
+	if current == "Ready" {
+		event.Type = "success"
+		event.Message = "✅ Node worker-1 in Member1 is now READY"
+	}
+	return event
+}
+
+func newTestEventLog() *EventLog {
+	el := NewEventLog(100)
+	el.SetAggregation(Aggregation{
+		DedupWindow:   5 * time.Minute,
+		FlapWindow:    2 * time.Minute,
+		FlapThreshold: 4,
+		StablePeriod:  2 * time.Minute,
+	})
+	return el
+}
+
+func TestDedupDoesNotMergeAcrossStateChanges(t *testing.T) {
+	el := newTestEventLog()
+	start := time.Now()
+
+	down := el.Record(nodeTransition("Ready", "NotReady", start))
+	recovered := el.Record(nodeTransition("NotReady", "Ready", start.Add(30*time.Second)))
+	downAgain := el.Record(nodeTransition("Ready", "NotReady", start.Add(time.Minute)))
+
+	if recovered.ID == down.ID {
+		t.Fatalf("recovery merged into outage event %d", down.ID)
+	}
+	if downAgain.ID == down.ID || downAgain.Count != 1 {
+		t.Fatalf("second outage = id %d count %d, want a new event (first outage id %d)", downAgain.ID, downAgain.Count, down.ID)
+	}
+	if events := el.GetEvents(); len(events) != 3 {
+		t.Fatalf("recorded %d events, want 3", len(events))
+	}
+}
+
+func TestDedupMergesConsecutiveIdenticalEvents(t *testing.T) {
+	el := newTestEventLog()
+	start := time.Now()
+
+	first := el.Record(nodeTransition("Ready", "NotReady", start))
+	repeat := el.Record(nodeTransition("Ready", "NotReady", start.Add(10*time.Second)))
+
+	if repeat.ID != first.ID || repeat.Count != 2 {
+		t.Fatalf("repeat = id %d count %d, want id %d count 2", repeat.ID, repeat.Count, first.ID)
+	}
+
+	// 다른 리소스의 전환은 기존 이벤트의 중복 제거에 영향 없음
+	other := nodeTransition("NotReady", "Ready", start.Add(20*time.Second))
+	other.InvolvedObject = &ObjectReference{Kind: "Node", Name: "worker-2"}
+	el.Record(other)
+
+	again := el.Record(nodeTransition("Ready", "NotReady", start.Add(30*time.Second)))
+	if again.ID != first.ID || again.Count != 3 {
+		t.Fatalf("again = id %d count %d, want id %d count 3", again.ID, again.Count, first.ID)
+	}
+}
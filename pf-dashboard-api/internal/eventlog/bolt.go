@@ -0,0 +1,239 @@
+package eventlog
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// eventsBucket 이벤트 버킷 이름 (키: 8바이트 big-endian 시퀀스)
+var eventsBucket = []byte("events")
+
+// BoltStore bbolt 파일 기반 이벤트 저장소
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// boltRecord 저장 형식
+// 구조화 이전에 저장된 레코드는 발생 시각을 createdAt에 가지고 있음
+type boltRecord struct {
+	Event
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// OpenBoltStore bbolt 저장소 열기 (파일과 상위 디렉터리가 없으면 생성)
+func OpenBoltStore(path string) (*BoltStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create event store directory: %w", err)
+	}
+
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event store: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(eventsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize event store: %w", err)
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// Append 이벤트 저장 (버킷 시퀀스를 ID로 사용)
+func (s *BoltStore) Append(event Event) (Event, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(eventsBucket)
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		event.ID = seq
+
+		data, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode event: %w", err)
+		}
+		return bucket.Put(sequenceKey(seq), data)
+	})
+	return event, err
+}
+
+// Update 저장된 이벤트 갱신 (집계된 이벤트의 횟수/마지막 발생 시각 반영)
+func (s *BoltStore) Update(event Event) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(eventsBucket)
+		key := sequenceKey(event.ID)
+		if bucket.Get(key) == nil {
+			return ErrEventNotFound
+		}
+
+		data, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode event: %w", err)
+		}
+		return bucket.Put(key, data)
+	})
+}
+
+// Recent 최근 limit개 이벤트 조회 (limit이 0 이하이면 전체)
+func (s *BoltStore) Recent(limit int) ([]Event, error) {
+	events := make([]Event, 0)
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(eventsBucket).Cursor()
+		for k, v := cursor.Last(); k != nil; k, v = cursor.Prev() {
+			if limit > 0 && len(events) >= limit {
+				break
+			}
+
+			event, err := decodeRecord(k, v)
+			if err != nil {
+				return err
+			}
+			events = append(events, event)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 최신순으로 읽었으므로 시간순으로 뒤집기
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	return events, nil
+}
+
+// Query 조건에 맞는 이벤트 조회 (커서 위치부터 키 순서로 탐색)
+func (s *BoltStore) Query(q Query) (Page, error) {
+	page := Page{Events: []Event{}}
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(eventsBucket).Cursor()
+
+		var k, v []byte
+		switch {
+		case q.Cursor == 0 && q.Ascending:
+			k, v = cursor.First()
+		case q.Cursor == 0:
+			k, v = cursor.Last()
+		case q.Ascending:
+			k, v = cursor.Seek(sequenceKey(q.Cursor + 1))
+		default:
+			// 커서 이상의 첫 키를 찾은 뒤 한 칸 앞으로 (없으면 마지막부터)
+			if k, _ = cursor.Seek(sequenceKey(q.Cursor)); k == nil {
+				k, v = cursor.Last()
+			} else {
+				k, v = cursor.Prev()
+			}
+		}
+
+		for ; k != nil; k, v = next(cursor, q.Ascending) {
+			event, err := decodeRecord(k, v)
+			if err != nil {
+				return err
+			}
+			if !page.collect(q, event) {
+				break
+			}
+		}
+		return nil
+	})
+	return page, err
+}
+
+// next 정렬 방향에 따라 커서 이동
+func next(cursor *bolt.Cursor, ascending bool) ([]byte, []byte) {
+	if ascending {
+		return cursor.Next()
+	}
+	return cursor.Prev()
+}
+
+// Prune 보관 기간/개수를 넘는 이벤트 삭제
+func (s *BoltStore) Prune(before time.Time, maxEntries int) (int, error) {
+	removed := 0
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(eventsBucket)
+		excess := 0
+		if maxEntries > 0 {
+			excess = bucket.Stats().KeyN - maxEntries
+		}
+
+		// 순회 중 커서로 삭제하면 키를 건너뛸 수 있으므로 키를 모은 뒤 삭제
+		expired := make([][]byte, 0)
+		cursor := bucket.Cursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			if len(expired) >= excess {
+				if before.IsZero() {
+					break
+				}
+				event, err := decodeRecord(k, v)
+				if err != nil {
+					return err
+				}
+				if !event.Time.Before(before) {
+					break
+				}
+			}
+			expired = append(expired, append([]byte(nil), k...))
+		}
+
+		for _, k := range expired {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		removed = len(expired)
+		return nil
+	})
+	return removed, err
+}
+
+// Close 저장소 닫기
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+// decodeRecord 저장된 이벤트 복원 (ID는 키에서 복원)
+func decodeRecord(key, data []byte) (Event, error) {
+	var record boltRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return Event{}, fmt.Errorf("failed to decode event: %w", err)
+	}
+
+	event := record.Event
+	event.ID = binary.BigEndian.Uint64(key)
+	if event.Time.IsZero() {
+		event.Time = record.CreatedAt
+	}
+	if event.Severity == "" {
+		event.Severity = severityForType(event.Type)
+	}
+	if event.Count == 0 {
+		// 집계 도입 이전 레코드
+		event.Count = 1
+		event.FirstSeen = event.Time
+		event.LastSeen = event.Time
+	}
+	return event, nil
+}
+
+// sequenceKey 시퀀스를 정렬 가능한 키로 변환
+func sequenceKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
@@ -1,55 +1,151 @@
 package eventlog
 
 import (
+	"log"
 	"sync"
 	"time"
 )
 
-// Event 구조체 정의
+// 이벤트 심각도
+const (
+	SeverityInfo     = "info"
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+// ObjectReference 이벤트와 관련된 리소스
+type ObjectReference struct {
+	Kind      string `json:"kind"` // Cluster, Node, Deployment, GSLBEndpoint ...
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// Event 구조화 이벤트
+// type, message, timestamp는 기존 UI 호환을 위해 유지
 type Event struct {
-	Type      string    `json:"type"`      // info, critical, auto, success
-	Message   string    `json:"message"`   // 이벤트 메시지
-	Timestamp string    `json:"timestamp"` // 타임스탬프
-	CreatedAt time.Time `json:"-"`         // 정렬용 (JSON 응답에는 포함 안됨)
+	ID             uint64            `json:"id"`                       // 저장소가 부여하는 단조 증가 ID
+	Type           string            `json:"type"`                     // info, critical, auto, success
+	Message        string            `json:"message"`                  // 사람이 읽는 메시지
+	Timestamp      string            `json:"timestamp"`                // "15:04:05" (UI 표시용)
+	Time           time.Time         `json:"time"`                     // 발생 시각 (RFC3339)
+	Severity       string            `json:"severity"`                 // info, warning, critical
+	Source         string            `json:"source,omitempty"`         // 이벤트를 만든 컴포넌트 (monitor, failover ...)
+	ClusterID      string            `json:"clusterId,omitempty"`      // 관련 클러스터 ID
+	InvolvedObject *ObjectReference  `json:"involvedObject,omitempty"` // 관련 리소스
+	Attributes     map[string]string `json:"attributes,omitempty"`     // 구조화 속성
+	Count          int               `json:"count"`                    // 집계된 발생 횟수
+	FirstSeen      time.Time         `json:"firstSeen"`                // 처음 발생 시각
+	LastSeen       time.Time         `json:"lastSeen"`                 // 마지막 발생 시각
 }
 
-// EventLog는 In-Memory 이벤트 로그를 관리
+// EventLog는 이벤트 저장소와 실시간 구독자를 관리
 type EventLog struct {
-	events   []Event
-	maxSize  int
-	mu       sync.RWMutex
-	watchers []chan Event
+	store       Store
+	retention   Retention
+	historySize int // GetEvents가 반환하는 최근 이벤트 수
+	aggregation Aggregation
+	mu          sync.RWMutex
+	watchers    []chan Event
+
+	recent map[string]*Event     // 중복 제거 기간 안의 이벤트 (키: dedupKey)
+	flaps  map[string]*flapState // 리소스별 상태 전환 기록 (키: objectKey)
 }
 
-// NewEventLog 새로운 이벤트 로그 생성
+// NewEventLog 최근 maxSize개를 메모리에 보관하는 이벤트 로그 생성
 func NewEventLog(maxSize int) *EventLog {
+	return NewEventLogWithStore(NewMemoryStore(maxSize), Retention{MaxEntries: maxSize}, maxSize)
+}
+
+// NewEventLogWithStore 저장소 기반 이벤트 로그 생성
+// historySize는 WebSocket 초기 전송 등 GetEvents가 반환하는 최근 이벤트 수
+func NewEventLogWithStore(store Store, retention Retention, historySize int) *EventLog {
 	return &EventLog{
-		events:   make([]Event, 0, maxSize),
-		maxSize:  maxSize,
-		watchers: make([]chan Event, 0),
+		store:       store,
+		retention:   retention,
+		historySize: historySize,
+		watchers:    make([]chan Event, 0),
+		recent:      make(map[string]*Event),
+		flaps:       make(map[string]*flapState),
 	}
 }
 
-// AddEvent 이벤트 추가
+// SetAggregation 중복 제거/flapping 억제 설정
+func (el *EventLog) SetAggregation(aggregation Aggregation) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+
+	el.aggregation = aggregation
+}
+
+// AddEvent 타입과 메시지만으로 이벤트 추가
 func (el *EventLog) AddEvent(eventType, message string) {
+	el.Record(Event{Type: eventType, Message: message})
+}
+
+// Record 구조화 이벤트 추가
+// ID, 시각, 표시용 타임스탬프는 자동으로 채우고, 심각도가 없으면 타입에서 유도
+// 중복 이벤트는 기존 이벤트의 횟수로 합치고, flapping 중인 리소스의 상태 전환은 억제
+// 반환값은 저장된(또는 합쳐진) 이벤트
+func (el *EventLog) Record(event Event) Event {
 	el.mu.Lock()
 	defer el.mu.Unlock()
 
-	event := Event{
-		Type:      eventType,
-		Message:   message,
-		Timestamp: time.Now().Format("15:04:05"),
-		CreatedAt: time.Now(),
+	normalize(&event)
+
+	if merged, ok := el.trackTransition(event); ok {
+		return merged
+	}
+	el.forgetPreviousState(event)
+	if merged, ok := el.mergeDuplicate(event); ok {
+		return merged
 	}
 
-	// 최대 크기 초과 시 오래된 이벤트 제거
-	if len(el.events) >= el.maxSize {
-		el.events = el.events[1:]
+	event = el.append(event)
+	el.remember(event)
+	return event
+}
+
+// normalize 자동으로 채우는 필드 설정
+func normalize(event *Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
 	}
+	event.Timestamp = event.Time.Format("15:04:05")
+	if event.Severity == "" {
+		event.Severity = severityForType(event.Type)
+	}
+	event.Count = 1
+	event.FirstSeen = event.Time
+	event.LastSeen = event.Time
+}
 
-	el.events = append(el.events, event)
+// append 새 이벤트 저장 후 구독자에게 전달 (el.mu 보유 상태에서 호출)
+func (el *EventLog) append(event Event) Event {
+	// 저장 실패해도 실시간 구독자에게는 전달
+	stored, err := el.store.Append(event)
+	if err != nil {
+		log.Printf("[EventLog] Failed to store event: %v", err)
+	} else {
+		event = stored
+	}
+
+	el.notify(event)
+	return event
+}
+
+// update 저장된 이벤트 갱신 후 구독자에게 전달 (el.mu 보유 상태에서 호출)
+func (el *EventLog) update(event Event) error {
+	if err := el.store.Update(event); err != nil {
+		return err
+	}
+
+	el.notify(event)
+	return nil
+}
 
-	// 모든 watcher에게 이벤트 전달
+// notify 모든 watcher에게 이벤트 전달
+// 같은 ID의 이벤트가 다시 오면 집계가 갱신된 것
+func (el *EventLog) notify(event Event) {
 	for _, watcher := range el.watchers {
 		select {
 		case watcher <- event:
@@ -59,23 +155,85 @@ func (el *EventLog) AddEvent(eventType, message string) {
 	}
 }
 
-// GetEvents 모든 이벤트 조회
-func (el *EventLog) GetEvents() []Event {
-	el.mu.RLock()
-	defer el.mu.RUnlock()
+// severityForType 기존 이벤트 타입의 기본 심각도
+func severityForType(eventType string) string {
+	if eventType == "critical" {
+		return SeverityCritical
+	}
+	return SeverityInfo
+}
 
-	// 복사본 반환
-	events := make([]Event, len(el.events))
-	copy(events, el.events)
+// GetEvents 최근 이벤트 조회 (시간순)
+func (el *EventLog) GetEvents() []Event {
+	events, err := el.store.Recent(el.historySize)
+	if err != nil {
+		log.Printf("[EventLog] Failed to read events: %v", err)
+		return []Event{}
+	}
 	return events
 }
 
+// Query 조건에 맞는 이벤트 조회
+func (el *EventLog) Query(q Query) (Page, error) {
+	return el.store.Query(q)
+}
+
+// RunRetention 주기적으로 보관 정책 적용 (블로킹)
+func (el *EventLog) RunRetention(interval time.Duration) {
+	el.applyRetention()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		el.applyRetention()
+	}
+}
+
+// applyRetention 보관 기간/개수를 넘는 이벤트와 만료된 전환 기록 삭제
+func (el *EventLog) applyRetention() {
+	el.pruneFlaps(time.Now())
+
+	var before time.Time
+	if el.retention.MaxAge > 0 {
+		before = time.Now().Add(-el.retention.MaxAge)
+	}
+
+	removed, err := el.store.Prune(before, el.retention.MaxEntries)
+	if err != nil {
+		log.Printf("[EventLog] Failed to apply retention: %v", err)
+		return
+	}
+	if removed > 0 {
+		log.Printf("[EventLog] Pruned %d events by retention policy", removed)
+	}
+}
+
+// Close 저장소 닫기
+func (el *EventLog) Close() error {
+	el.mu.Lock()
+	for _, state := range el.flaps {
+		if state.timer != nil {
+			state.timer.Stop()
+		}
+	}
+	el.mu.Unlock()
+
+	return el.store.Close()
+}
+
 // Watch 이벤트 변경 감지 채널 등록
 func (el *EventLog) Watch() chan Event {
+	return el.WatchBuffered(10)
+}
+
+// WatchBuffered 버퍼 크기를 지정해 감지 채널 등록
+// 이벤트를 놓치면 안 되는 구독자(인시던트 관리 등)가 사용
+func (el *EventLog) WatchBuffered(size int) chan Event {
 	el.mu.Lock()
 	defer el.mu.Unlock()
 
-	watcher := make(chan Event, 10)
+	watcher := make(chan Event, size)
 	el.watchers = append(el.watchers, watcher)
 	return watcher
 }
@@ -0,0 +1,205 @@
+package eventlog
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// 외부 이벤트 입력 제한
+const (
+	maxMessageLength   = 1024
+	maxAttributes      = 32
+	maxAttributeKey    = 63
+	maxAttributeValue  = 1024
+	maxNameLength      = 253
+	maxFutureClockSkew = 5 * time.Minute
+)
+
+// reportedTimeAttribute 클라이언트가 보낸 발생 시각을 보관하는 속성
+const reportedTimeAttribute = "reportedTime"
+
+// ErrIngestDisabled 외부 이벤트 입력 토큰이 설정되지 않음
+var ErrIngestDisabled = errors.New("event ingestion disabled (EVENT_INGEST_TOKENS not set)")
+
+// reservedSources 내부 컴포넌트 소스 이름 (외부 클라이언트 이름으로 사용 불가)
+var reservedSources = map[string]bool{
+	"monitor":         true,
+	"kubernetes":      true,
+	"rollout":         true,
+	"workload-drift":  true,
+	"karmada":         true,
+	"failover":        true,
+	"gslb-reconciler": true,
+	"dns-probe":       true,
+	"http-probe":      true,
+	"alertmanager":    true,
+	"notifier":        true,
+}
+
+// IngestRequest 외부 이벤트 입력 본문
+// ID, 집계 필드, 소스는 서버가 정함
+type IngestRequest struct {
+	Type           string            `json:"type,omitempty"`     // info(기본값), critical, auto, success
+	Severity       string            `json:"severity,omitempty"` // 비어 있으면 타입에서 유도
+	Message        string            `json:"message"`
+	ClusterID      string            `json:"clusterId,omitempty"`
+	InvolvedObject *ObjectReference  `json:"involvedObject,omitempty"`
+	Attributes     map[string]string `json:"attributes,omitempty"`
+	Time           *time.Time        `json:"time,omitempty"` // 클라이언트 발생 시각 (reportedTime 속성으로 보관)
+}
+
+// Ingester 인증된 외부 클라이언트(CI, Karmada hook, chaos 스크립트 등)의 이벤트를 기록
+type Ingester struct {
+	eventLog *EventLog
+	clients  map[string]string // 토큰 -> 클라이언트 이름 (이벤트 소스로 사용)
+}
+
+// NewIngesterFromEnv 환경변수 기반 Ingester 생성
+// EVENT_INGEST_TOKENS="ci=<token>,chaos=<token>" 형식으로 클라이언트별 토큰 지정
+func NewIngesterFromEnv(eventLog *EventLog) *Ingester {
+	ingester := &Ingester{
+		eventLog: eventLog,
+		clients:  make(map[string]string),
+	}
+
+	for _, item := range strings.Split(os.Getenv("EVENT_INGEST_TOKENS"), ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, token, ok := strings.Cut(item, "=")
+		name, token = strings.TrimSpace(name), strings.TrimSpace(token)
+		switch {
+		case !ok || name == "" || token == "":
+			log.Printf("Warning: invalid EVENT_INGEST_TOKENS entry (expected name=token)")
+		case reservedSources[strings.ToLower(name)]:
+			log.Printf("Warning: EVENT_INGEST_TOKENS client name %q is reserved for internal events, skipping", name)
+		default:
+			ingester.clients[token] = name
+		}
+	}
+
+	if len(ingester.clients) > 0 {
+		log.Printf("[EventLog] External event ingestion enabled for %d clients", len(ingester.clients))
+	}
+	return ingester
+}
+
+// Enabled 입력 토큰이 하나라도 설정되었는지
+func (i *Ingester) Enabled() bool {
+	return len(i.clients) > 0
+}
+
+// Authenticate Bearer 토큰으로 클라이언트 이름 조회
+func (i *Ingester) Authenticate(authorization string) (string, bool) {
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || token == "" {
+		return "", false
+	}
+
+	// 일치 여부와 관계없이 모든 토큰과 비교
+	client := ""
+	for candidate, name := range i.clients {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(candidate)) == 1 {
+			client = name
+		}
+	}
+	return client, client != ""
+}
+
+// Ingest 입력을 검증해 클라이언트 이름을 소스로 기록하고 저장된 이벤트 반환
+// 기록된 이벤트는 내부 이벤트와 같이 WebSocket 등 모든 구독자에게 전달
+func (i *Ingester) Ingest(client string, req IngestRequest) (Event, error) {
+	if !i.Enabled() {
+		return Event{}, ErrIngestDisabled
+	}
+
+	event, err := req.toEvent(time.Now())
+	if err != nil {
+		return Event{}, err
+	}
+	event.Source = client
+
+	return i.eventLog.Record(event), nil
+}
+
+// toEvent 입력 검증 후 이벤트로 변환
+func (r IngestRequest) toEvent(now time.Time) (Event, error) {
+	event := Event{
+		Type:      r.Type,
+		Severity:  r.Severity,
+		Message:   strings.TrimSpace(r.Message),
+		ClusterID: strings.TrimSpace(r.ClusterID),
+	}
+
+	switch event.Type {
+	case "":
+		event.Type = "info"
+	case "info", "critical", "auto", "success":
+	default:
+		return Event{}, fmt.Errorf("invalid type %q (info, critical, auto, success)", r.Type)
+	}
+
+	switch event.Severity {
+	case "", SeverityInfo, SeverityWarning, SeverityCritical:
+	default:
+		return Event{}, fmt.Errorf("invalid severity %q (info, warning, critical)", r.Severity)
+	}
+
+	if event.Message == "" {
+		return Event{}, errors.New("message is required")
+	}
+	if len(event.Message) > maxMessageLength {
+		return Event{}, fmt.Errorf("message exceeds %d bytes", maxMessageLength)
+	}
+	if len(event.ClusterID) > maxNameLength {
+		return Event{}, fmt.Errorf("clusterId exceeds %d bytes", maxNameLength)
+	}
+
+	if obj := r.InvolvedObject; obj != nil {
+		if obj.Kind == "" || obj.Name == "" {
+			return Event{}, errors.New("involvedObject requires kind and name")
+		}
+		if len(obj.Kind) > maxNameLength || len(obj.Namespace) > maxNameLength || len(obj.Name) > maxNameLength {
+			return Event{}, fmt.Errorf("involvedObject fields exceed %d bytes", maxNameLength)
+		}
+		ref := *obj
+		event.InvolvedObject = &ref
+	}
+
+	if len(r.Attributes) > maxAttributes {
+		return Event{}, fmt.Errorf("too many attributes (max %d)", maxAttributes)
+	}
+	for k, v := range r.Attributes {
+		if k == "" || len(k) > maxAttributeKey {
+			return Event{}, fmt.Errorf("attribute key %q must be 1-%d bytes", k, maxAttributeKey)
+		}
+		if len(v) > maxAttributeValue {
+			return Event{}, fmt.Errorf("attribute %q exceeds %d bytes", k, maxAttributeValue)
+		}
+		if event.Attributes == nil {
+			event.Attributes = make(map[string]string, len(r.Attributes))
+		}
+		event.Attributes[k] = v
+	}
+
+	// 이벤트 시각은 항상 수신 시각
+	// 과거 시각으로 보관 정책, 중복 제거, 인시던트 기간 판단을 우회하지 못하도록 클라이언트 시각은 속성으로만 보관
+	event.Time = now
+	if r.Time != nil {
+		if r.Time.After(now.Add(maxFutureClockSkew)) {
+			return Event{}, fmt.Errorf("time %s is in the future", r.Time.Format(time.RFC3339))
+		}
+		if event.Attributes == nil {
+			event.Attributes = make(map[string]string, 1)
+		}
+		event.Attributes[reportedTimeAttribute] = r.Time.Format(time.RFC3339Nano)
+	}
+
+	return event, nil
+}
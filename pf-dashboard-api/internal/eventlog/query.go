@@ -0,0 +1,114 @@
+package eventlog
+
+import (
+	"strings"
+	"time"
+)
+
+// Query 이벤트 조회 조건
+type Query struct {
+	Types      []string  // 비어 있으면 모든 타입
+	Severities []string  // 비어 있으면 모든 심각도
+	Sources    []string  // 비어 있으면 모든 컴포넌트
+	Cluster    string    // 클러스터 ID (대소문자 무시)
+	Resource   string    // 리소스 이름, kind/name 또는 namespace/name (대소문자 무시)
+	Text       string    // 메시지 검색어 (대소문자 무시)
+	Since      time.Time // 이 시각 이후 (포함)
+	Until      time.Time // 이 시각 이전 (미포함)
+	Cursor     uint64    // 이전 페이지의 마지막 ID (0이면 처음부터)
+	Limit      int
+	Ascending  bool // true면 오래된 순, false면 최신순
+}
+
+// Page 이벤트 조회 결과 한 페이지
+type Page struct {
+	Events     []Event `json:"events"`
+	NextCursor uint64  `json:"nextCursor,omitempty"` // 다음 페이지가 없으면 0
+}
+
+// Matches 이벤트가 조회 조건을 만족하는지 (커서 제외)
+func (q Query) Matches(e Event) bool {
+	if len(q.Types) > 0 && !containsFold(q.Types, e.Type) {
+		return false
+	}
+	if len(q.Severities) > 0 && !containsFold(q.Severities, e.Severity) {
+		return false
+	}
+	if len(q.Sources) > 0 && !containsFold(q.Sources, e.Source) {
+		return false
+	}
+	if !q.Since.IsZero() && e.Time.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && !e.Time.Before(q.Until) {
+		return false
+	}
+
+	message := strings.ToLower(e.Message)
+	if q.Cluster != "" && !matchesCluster(e, q.Cluster, message) {
+		return false
+	}
+	if q.Resource != "" && !matchesResource(e, q.Resource, message) {
+		return false
+	}
+	if q.Text != "" && !strings.Contains(message, strings.ToLower(q.Text)) {
+		return false
+	}
+	return true
+}
+
+// matchesCluster 클러스터 ID 비교 (구조화 이전 이벤트는 메시지에서 검색)
+func matchesCluster(e Event, cluster, message string) bool {
+	if e.ClusterID != "" {
+		return strings.EqualFold(e.ClusterID, cluster)
+	}
+	return strings.Contains(message, strings.ToLower(cluster))
+}
+
+// matchesResource 관련 리소스 비교 (리소스 정보가 없으면 메시지에서 검색)
+func matchesResource(e Event, resource, message string) bool {
+	obj := e.InvolvedObject
+	if obj == nil {
+		return strings.Contains(message, strings.ToLower(resource))
+	}
+	return strings.EqualFold(obj.Name, resource) ||
+		strings.EqualFold(obj.Kind+"/"+obj.Name, resource) ||
+		strings.EqualFold(obj.Namespace+"/"+obj.Name, resource)
+}
+
+// afterCursor 이벤트가 커서 다음 위치인지
+func (q Query) afterCursor(id uint64) bool {
+	if q.Cursor == 0 {
+		return true
+	}
+	if q.Ascending {
+		return id > q.Cursor
+	}
+	return id < q.Cursor
+}
+
+// collect 순서대로 전달되는 이벤트를 모아 페이지 구성
+// 다음 이벤트를 받아야 하면 true 반환
+func (p *Page) collect(q Query, e Event) bool {
+	if !q.afterCursor(e.ID) || !q.Matches(e) {
+		return true
+	}
+
+	if q.Limit > 0 && len(p.Events) >= q.Limit {
+		// limit을 넘는 일치 항목이 있으면 다음 페이지 존재
+		p.NextCursor = p.Events[len(p.Events)-1].ID
+		return false
+	}
+	p.Events = append(p.Events, e)
+	return true
+}
+
+// containsFold 대소문자 무시 포함 여부
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+package eventlog
+
+import (
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrEventNotFound 갱신할 이벤트가 없음 (보관 정책으로 삭제된 경우 등)
+var ErrEventNotFound = errors.New("event not found")
+
+// Store 이벤트 저장소 인터페이스
+// 구현체는 이벤트를 추가 순서(시간순)로 보관해야 함
+type Store interface {
+	// Append 이벤트 저장 (ID가 부여된 이벤트 반환)
+	Append(event Event) (Event, error)
+	// Update 같은 ID로 저장된 이벤트 갱신 (없으면 ErrEventNotFound)
+	Update(event Event) error
+	// Recent 최근 limit개 이벤트를 시간순(오래된 것 먼저)으로 조회
+	Recent(limit int) ([]Event, error)
+	// Query 조건에 맞는 이벤트를 ID 순서로 페이지 단위 조회
+	Query(q Query) (Page, error)
+	// Prune before 이전 이벤트와 maxEntries를 넘는 오래된 이벤트 삭제 (0이면 해당 조건 미적용)
+	Prune(before time.Time, maxEntries int) (int, error)
+	// Close 저장소 닫기
+	Close() error
+}
+
+// Retention 이벤트 보관 정책
+type Retention struct {
+	MaxAge     time.Duration // 0이면 기간 제한 없음
+	MaxEntries int           // 0이면 개수 제한 없음
+}
+
+// MemoryStore 메모리 기반 저장소 (재시작 시 유실)
+type MemoryStore struct {
+	events  []Event
+	maxSize int
+	lastID  uint64
+	mu      sync.RWMutex
+}
+
+// NewMemoryStore 최대 maxSize개를 보관하는 메모리 저장소 생성
+func NewMemoryStore(maxSize int) *MemoryStore {
+	return &MemoryStore{
+		events:  make([]Event, 0, maxSize),
+		maxSize: maxSize,
+	}
+}
+
+// Append 이벤트 저장 (최대 크기 초과 시 오래된 이벤트 제거)
+func (s *MemoryStore) Append(event Event) (Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastID++
+	event.ID = s.lastID
+
+	if s.maxSize > 0 && len(s.events) >= s.maxSize {
+		s.events = s.events[1:]
+	}
+	s.events = append(s.events, event)
+	return event, nil
+}
+
+// Update 저장된 이벤트 갱신
+func (s *MemoryStore) Update(event Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// ID는 추가 순서대로 증가
+	i := sort.Search(len(s.events), func(i int) bool { return s.events[i].ID >= event.ID })
+	if i == len(s.events) || s.events[i].ID != event.ID {
+		return ErrEventNotFound
+	}
+	s.events[i] = event
+	return nil
+}
+
+// Recent 최근 limit개 이벤트 조회
+func (s *MemoryStore) Recent(limit int) ([]Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	start := 0
+	if limit > 0 && len(s.events) > limit {
+		start = len(s.events) - limit
+	}
+
+	// 복사본 반환
+	events := make([]Event, len(s.events)-start)
+	copy(events, s.events[start:])
+	return events, nil
+}
+
+// Query 조건에 맞는 이벤트 조회
+func (s *MemoryStore) Query(q Query) (Page, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	page := Page{Events: []Event{}}
+	if q.Ascending {
+		for _, e := range s.events {
+			if !page.collect(q, e) {
+				break
+			}
+		}
+	} else {
+		for i := len(s.events) - 1; i >= 0; i-- {
+			if !page.collect(q, s.events[i]) {
+				break
+			}
+		}
+	}
+	return page, nil
+}
+
+// Prune 보관 기간/개수를 넘는 이벤트 삭제
+func (s *MemoryStore) Prune(before time.Time, maxEntries int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	drop := 0
+	if !before.IsZero() {
+		for drop < len(s.events) && s.events[drop].Time.Before(before) {
+			drop++
+		}
+	}
+	if maxEntries > 0 && len(s.events)-drop > maxEntries {
+		drop = len(s.events) - maxEntries
+	}
+
+	s.events = append([]Event(nil), s.events[drop:]...)
+	return drop, nil
+}
+
+// Close 메모리 저장소는 닫을 자원이 없음
+func (s *MemoryStore) Close() error {
+	return nil
+}
+
+// NewStoreFromEnv 환경변수 기반 이벤트 저장소와 보관 정책 생성
+// EVENT_STORE=bolt(기본값)이면 EVENT_STORE_PATH 파일에 저장하고, 열 수 없으면 메모리 저장소 사용
+func NewStoreFromEnv() (Store, Retention) {
+	retention := Retention{
+		MaxAge:     getDurationEnv("EVENT_RETENTION", 7*24*time.Hour),
+		MaxEntries: getIntEnv("EVENT_MAX_ENTRIES", 10000),
+	}
+
+	kind := strings.ToLower(os.Getenv("EVENT_STORE"))
+	switch kind {
+	case "memory":
+		log.Printf("[EventLog] Using in-memory event store (max %d events)", retention.MaxEntries)
+		return NewMemoryStore(retention.MaxEntries), retention
+	case "", "bolt":
+	default:
+		log.Printf("Warning: unknown EVENT_STORE %q, falling back to bolt", kind)
+	}
+
+	path := os.Getenv("EVENT_STORE_PATH")
+	if path == "" {
+		path = filepath.Join("data", "events.db")
+	}
+
+	store, err := OpenBoltStore(path)
+	if err != nil {
+		log.Printf("Warning: failed to open event store %s, events will not survive restarts: %v", path, err)
+		return NewMemoryStore(retention.MaxEntries), retention
+	}
+
+	log.Printf("[EventLog] Using bolt event store: %s (retention: %s, max %d events)",
+		path, retention.MaxAge, retention.MaxEntries)
+	return store, retention
+}
+
+// getDurationEnv 기간 환경변수 조회 ("7d"처럼 일 단위도 허용)
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil {
+			return time.Duration(n) * 24 * time.Hour
+		}
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Warning: invalid %s %q, using %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return d
+}
+
+// getIntEnv 정수 환경변수 조회
+func getIntEnv(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Warning: invalid %s %q, using %d", key, value, defaultValue)
+		return defaultValue
+	}
+	return n
+}
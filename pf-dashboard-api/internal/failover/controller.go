@@ -0,0 +1,644 @@
+package failover
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	"github.com/minkyulee/pf-dashboard-backend/internal/gslb"
+	"github.com/minkyulee/pf-dashboard-backend/internal/monitor"
+)
+
+// 컨트롤러 동작 모드
+const (
+	ModeOff     = "off"     // 감시하지 않음
+	ModeDryRun  = "dry-run" // 수행할 조치를 이벤트로만 보고
+	ModeEnforce = "enforce" // 실제 GSLB 엔드포인트 변경
+)
+
+// Rules 자동 Failover 정책
+type Rules struct {
+	Mode               string
+	GracePeriod        time.Duration // 장애가 이 시간 이상 지속되면 격리
+	RecoveryPeriod     time.Duration // 복구가 이 시간 이상 안정적이면 복원
+	MinHealthyClusters int           // 격리 후 남아 있어야 하는 정상 클러스터 수
+	KeepLastEndpoint   bool          // 마지막 활성 엔드포인트는 비활성화하지 않음
+}
+
+// MarshalJSON 기간을 "30s" 같은 문자열로 출력
+func (r Rules) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"mode":               r.Mode,
+		"gracePeriod":        r.GracePeriod.String(),
+		"recoveryPeriod":     r.RecoveryPeriod.String(),
+		"minHealthyClusters": r.MinHealthyClusters,
+		"keepLastEndpoint":   r.KeepLastEndpoint,
+	})
+}
+
+// Target 클러스터와 GSLB 엔드포인트 매핑
+type Target struct {
+	PoolID  string `json:"poolId"`
+	Address string `json:"address"`
+}
+
+// retryInterval 격리 보류/실패 후 다시 시도하기까지의 간격
+const retryInterval = 30 * time.Second
+
+// ClusterStatus 클러스터별 Failover 상태
+type ClusterStatus struct {
+	ClusterID    string     `json:"clusterId"`
+	Status       string     `json:"status"`               // 마지막으로 관측한 클러스터 상태
+	Isolated     bool       `json:"isolated"`             // 컨트롤러가 엔드포인트를 하나 이상 비활성화했는지
+	Incomplete   bool       `json:"incomplete,omitempty"` // 일부 엔드포인트 비활성화에 실패해 재시도 중인지
+	Held         bool       `json:"held"`                 // 격리 보류 중인지 (최소 정상 클러스터, 마지막 엔드포인트, 대상 없음)
+	HeldReason   string     `json:"heldReason,omitempty"`
+	FailingSince *time.Time `json:"failingSince,omitempty"`
+	HealthySince *time.Time `json:"healthySince,omitempty"`
+	Targets      []Target   `json:"targets"` // 컨트롤러가 비활성화한 엔드포인트
+
+	nextAttempt time.Time // 보류/실패 후 다음 격리 시도 시각
+}
+
+// Controller 클러스터 헬스 기반 GSLB 자동 Failover 컨트롤러
+type Controller struct {
+	provider gslb.Provider
+	eventLog *eventlog.EventLog
+	rules    Rules
+	gslbName string
+	mapping  map[string][]Target // 명시적 매핑 [clusterID]
+	store    *Store              // 격리 대상 저장소 (없으면 메모리에만 보관)
+
+	evalMu   sync.Mutex // Evaluate 동시 실행 방지 (GSLB 변경은 c.mu 밖에서 수행)
+	clusters map[string]*ClusterStatus
+	mu       sync.RWMutex
+}
+
+// NewControllerFromEnv 환경변수 기반 Failover 컨트롤러 생성
+func NewControllerFromEnv(provider gslb.Provider, eventLog *eventlog.EventLog) *Controller {
+	rules := Rules{
+		Mode:               strings.ToLower(getEnv("FAILOVER_MODE", ModeOff)),
+		GracePeriod:        getDurationEnv("FAILOVER_GRACE_PERIOD", 30*time.Second),
+		RecoveryPeriod:     getDurationEnv("FAILOVER_RECOVERY_PERIOD", 60*time.Second),
+		MinHealthyClusters: getIntEnv("FAILOVER_MIN_HEALTHY_CLUSTERS", 1),
+		KeepLastEndpoint:   getEnv("FAILOVER_KEEP_LAST_ENDPOINT", "true") != "false",
+	}
+
+	switch rules.Mode {
+	case ModeOff, ModeDryRun, ModeEnforce:
+	default:
+		log.Printf("Warning: unknown FAILOVER_MODE %q, falling back to %s", rules.Mode, ModeOff)
+		rules.Mode = ModeOff
+	}
+
+	c := &Controller{
+		provider: provider,
+		eventLog: eventLog,
+		rules:    rules,
+		gslbName: getEnv("FAILOVER_GSLB_NAME", os.Getenv("GSLB_NAME")),
+		mapping:  parseMapping(os.Getenv("FAILOVER_ENDPOINTS")),
+		clusters: make(map[string]*ClusterStatus),
+	}
+
+	// dry-run은 실제로 비활성화하지 않으므로 저장하지 않음
+	if rules.Mode != ModeEnforce {
+		return c
+	}
+
+	kind := strings.ToLower(os.Getenv("FAILOVER_STORE"))
+	switch kind {
+	case "memory":
+		log.Printf("Warning: [Failover] Isolated endpoints are kept in memory only (FAILOVER_STORE=memory)")
+		return c
+	case "", "bolt":
+	default:
+		log.Printf("Warning: unknown FAILOVER_STORE %q, falling back to bolt", kind)
+	}
+
+	path := getEnv("FAILOVER_STORE_PATH", filepath.Join("data", "failover.db"))
+	store, err := OpenStore(path)
+	if err != nil {
+		log.Printf("Warning: failed to open failover store %s, isolated endpoints will not survive restarts: %v", path, err)
+		return c
+	}
+	if err := c.restoreState(store); err != nil {
+		log.Printf("Warning: failed to load isolated endpoints from %s: %v", path, err)
+	}
+	return c
+}
+
+// restoreState 저장된 격리 대상을 불러오고 이후 변경을 저장소에 기록
+// 복원한 클러스터는 격리 상태로 시작해 복구가 RecoveryPeriod 이상 유지되면 재활성화
+func (c *Controller) restoreState(store *Store) error {
+	c.store = store
+
+	isolated, err := store.Load()
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for clusterID, targets := range isolated {
+		state := c.clusterState(clusterID)
+		state.Targets = targets
+		state.Isolated = len(targets) > 0
+		log.Printf("[Failover] Restored isolation of %s (%d endpoints)", clusterID, len(targets))
+	}
+	return nil
+}
+
+// Rules 현재 정책 반환
+func (c *Controller) Rules() Rules {
+	return c.rules
+}
+
+// Run 클러스터 상태 채널을 구독하며 정책 평가 (채널이 닫힐 때까지 블로킹)
+func (c *Controller) Run(updates <-chan []monitor.ClusterInfo) {
+	if c.rules.Mode == ModeOff {
+		log.Printf("[Failover] Controller disabled (FAILOVER_MODE=off)")
+		return
+	}
+
+	log.Printf("[Failover] Controller started (mode: %s, grace: %s, recovery: %s, min healthy: %d)",
+		c.rules.Mode, c.rules.GracePeriod, c.rules.RecoveryPeriod, c.rules.MinHealthyClusters)
+
+	for clusters := range updates {
+		c.Evaluate(clusters, time.Now())
+	}
+}
+
+// step 평가 결과로 수행할 격리/복원 조치
+type step struct {
+	cluster      monitor.ClusterInfo
+	state        ClusterStatus // 판단 시점의 상태 복사본
+	restore      bool
+	healthyCount int
+}
+
+// isolation 격리 시도 결과
+type isolation struct {
+	disabled   []Target
+	failed     bool   // 조회/비활성화 실패
+	backoff    bool   // 다음 시도를 retryInterval 뒤로 미룸 (GSLB 조회가 필요한 실패/보류)
+	heldReason string // 격리를 보류한 이유 (비어 있으면 보류 아님)
+}
+
+// Evaluate 클러스터 상태를 정책과 비교해 격리/복원 수행
+// 판단과 상태 반영만 c.mu 안에서 하고, GSLB API 호출은 c.mu 밖에서 수행해 Status/IsIsolated 조회를 막지 않음
+func (c *Controller) Evaluate(clusters []monitor.ClusterInfo, now time.Time) {
+	c.evalMu.Lock()
+	defer c.evalMu.Unlock()
+
+	for _, s := range c.plan(clusters, now) {
+		if s.restore {
+			remaining := c.restore(s.cluster, s.state.Targets)
+
+			c.mu.Lock()
+			state := c.clusterState(s.cluster.ID)
+			state.Targets = remaining
+			state.Isolated = len(remaining) > 0
+			state.Incomplete = false
+			c.mu.Unlock()
+
+			c.save(s.cluster.ID, remaining)
+			continue
+		}
+
+		result := c.isolate(s.cluster, s.state, s.healthyCount)
+
+		c.mu.Lock()
+		state := c.clusterState(s.cluster.ID)
+		state.Targets = append(state.Targets, result.disabled...)
+		state.Isolated = len(state.Targets) > 0
+		state.Incomplete = state.Isolated && result.failed
+		state.Held = result.heldReason != ""
+		state.HeldReason = result.heldReason
+		if result.backoff {
+			state.nextAttempt = now.Add(retryInterval)
+		}
+		targets := append([]Target{}, state.Targets...)
+		c.mu.Unlock()
+
+		if len(result.disabled) > 0 {
+			c.save(s.cluster.ID, targets)
+		}
+	}
+}
+
+// save 클러스터의 격리 대상 저장 (c.mu 밖에서 호출)
+func (c *Controller) save(clusterID string, targets []Target) {
+	if c.store == nil {
+		return
+	}
+	if err := c.store.Save(clusterID, targets); err != nil {
+		log.Printf("Warning: [Failover] Failed to save isolated endpoints of %s: %v", clusterID, err)
+	}
+}
+
+// plan 클러스터 상태를 갱신하고 수행할 조치 결정
+func (c *Controller) plan(clusters []monitor.ClusterInfo, now time.Time) []step {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	healthyCount := 0
+	for _, cluster := range clusters {
+		if cluster.Status == "ready" {
+			healthyCount++
+		}
+	}
+
+	steps := make([]step, 0)
+	for _, cluster := range clusters {
+		state := c.clusterState(cluster.ID)
+		state.Status = cluster.Status
+
+		if cluster.Status == "failure" {
+			state.HealthySince = nil
+			if state.FailingSince == nil {
+				state.FailingSince = timePtr(now)
+			}
+
+			// 격리 전이거나 일부 실패/보류된 경우 재시도
+			pending := !state.Isolated || state.Incomplete
+			if pending && now.Sub(*state.FailingSince) >= c.rules.GracePeriod && !now.Before(state.nextAttempt) {
+				steps = append(steps, step{cluster: cluster, state: state.copy(), healthyCount: healthyCount})
+			}
+			continue
+		}
+
+		state.FailingSince = nil
+		state.Held = false
+		state.HeldReason = ""
+		state.nextAttempt = time.Time{}
+		if state.HealthySince == nil {
+			state.HealthySince = timePtr(now)
+		}
+
+		if state.Isolated && now.Sub(*state.HealthySince) >= c.rules.RecoveryPeriod {
+			steps = append(steps, step{cluster: cluster, state: state.copy(), restore: true})
+		}
+	}
+	return steps
+}
+
+// Status 클러스터별 Failover 상태 조회
+func (c *Controller) Status() []ClusterStatus {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	statuses := make([]ClusterStatus, 0, len(c.clusters))
+	for _, state := range c.clusters {
+		statuses = append(statuses, state.copy())
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].ClusterID < statuses[j].ClusterID })
+	return statuses
+}
+
+// IsIsolated 엔드포인트가 컨트롤러에 의해 격리(비활성화)된 상태인지
+// dry-run에서 격리한 것으로 기록한 엔드포인트는 실제로 비활성화되지 않았으므로 제외
+func (c *Controller) IsIsolated(poolID, address string) bool {
+	if c.rules.Mode == ModeDryRun {
+		return false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, state := range c.clusters {
+		for _, target := range state.Targets {
+			if target.PoolID == poolID && target.Address == address {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isolate 장애 클러스터의 활성 GSLB 엔드포인트 비활성화 (이미 비활성화한 엔드포인트는 제외됨)
+func (c *Controller) isolate(cluster monitor.ClusterInfo, prev ClusterStatus, healthyCount int) isolation {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if healthyCount < c.rules.MinHealthyClusters {
+		// 정상 클러스터가 늘어나면 바로 다시 시도
+		return c.hold(cluster, prev, fmt.Sprintf("정상 클러스터 %d개 (최소 %d개 필요)", healthyCount, c.rules.MinHealthyClusters), false)
+	}
+
+	targets, err := c.resolveTargets(ctx, cluster.ID)
+	if err != nil {
+		log.Printf("[Failover] Failed to resolve GSLB endpoints for %s: %v", cluster.ID, err)
+		return isolation{failed: true, backoff: true}
+	}
+	if len(targets) == 0 {
+		if prev.Isolated {
+			// 재시도 대상이 이미 비활성화됨
+			return isolation{}
+		}
+		return c.hold(cluster, prev, "매핑된 활성 GSLB 엔드포인트가 없어 격리할 대상 없음", true)
+	}
+
+	enabled, err := c.enabledEndpointCount(ctx)
+	if err != nil {
+		log.Printf("[Failover] Failed to count enabled endpoints: %v", err)
+		return isolation{failed: true, backoff: true}
+	}
+
+	var blocked []Target
+	if c.rules.KeepLastEndpoint {
+		limit := enabled - 1
+		if limit < 0 {
+			limit = 0
+		}
+		if len(targets) > limit {
+			targets, blocked = targets[:limit], targets[limit:]
+		}
+	}
+	if len(targets) == 0 {
+		return c.hold(cluster, prev, fmt.Sprintf("마지막 활성 엔드포인트 %s는 비활성화하지 않음", blocked[0].Address), true)
+	}
+
+	if prev.Isolated {
+		c.report(cluster.ID, nil, fmt.Sprintf("%s 격리 재시도: 남은 엔드포인트 %d개", cluster.Name, len(targets)))
+	} else {
+		c.report(cluster.ID, nil, fmt.Sprintf("%s 장애가 %s 이상 지속되어 GSLB에서 격리 시작", cluster.Name, c.rules.GracePeriod))
+	}
+
+	result := isolation{disabled: make([]Target, 0, len(targets))}
+	for _, target := range targets {
+		if err := c.setDisabled(ctx, target, true); err != nil {
+			log.Printf("[Failover] Failed to disable %s: %v", target.Address, err)
+			c.eventLog.Record(eventlog.Event{
+				Type:           "critical",
+				Message:        fmt.Sprintf("GSLB 엔드포인트 %s 비활성화 실패: %v", target.Address, err),
+				Source:         "failover",
+				ClusterID:      cluster.ID,
+				InvolvedObject: target.objectRef(),
+				Attributes:     map[string]string{"poolId": target.PoolID, "mode": c.rules.Mode},
+			})
+			result.failed = true
+			result.backoff = true
+			continue
+		}
+		result.disabled = append(result.disabled, target)
+		c.report(cluster.ID, &target, fmt.Sprintf("GSLB 엔드포인트 %s (풀 %s) 비활성화", target.Address, target.PoolID))
+	}
+	for _, target := range blocked {
+		target := target
+		c.report(cluster.ID, &target, fmt.Sprintf("마지막 활성 엔드포인트 %s는 비활성화하지 않음", target.Address))
+	}
+
+	if len(result.disabled) == 0 {
+		log.Printf("[Failover] Isolation of %s failed, retrying in %s", cluster.ID, retryInterval)
+	}
+	return result
+}
+
+// hold 격리 보류 (같은 이유는 반복 보고하지 않음)
+func (c *Controller) hold(cluster monitor.ClusterInfo, prev ClusterStatus, reason string, backoff bool) isolation {
+	if prev.HeldReason != reason {
+		c.report(cluster.ID, nil, fmt.Sprintf("%s 격리 보류: %s", cluster.Name, reason))
+	}
+	return isolation{heldReason: reason, backoff: backoff}
+}
+
+// restore 안정적으로 복구된 클러스터의 GSLB 엔드포인트 재활성화 (재활성화하지 못한 엔드포인트 반환)
+func (c *Controller) restore(cluster monitor.ClusterInfo, targets []Target) []Target {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	remaining := make([]Target, 0)
+	for _, target := range targets {
+		if err := c.setDisabled(ctx, target, false); err != nil {
+			log.Printf("[Failover] Failed to enable %s: %v", target.Address, err)
+			remaining = append(remaining, target)
+			continue
+		}
+		c.report(cluster.ID, &target, fmt.Sprintf("GSLB 엔드포인트 %s (풀 %s) 재활성화", target.Address, target.PoolID))
+	}
+
+	if len(remaining) > 0 {
+		return remaining // 다음 평가에서 재시도
+	}
+
+	c.report(cluster.ID, nil, fmt.Sprintf("%s 복구가 %s 이상 안정적으로 유지되어 트래픽 복원 완료", cluster.Name, c.rules.RecoveryPeriod))
+	return remaining
+}
+
+// setDisabled 엔드포인트 활성 상태 변경 (dry-run이면 변경하지 않음)
+func (c *Controller) setDisabled(ctx context.Context, target Target, disabled bool) error {
+	if c.rules.Mode == ModeDryRun {
+		return nil
+	}
+
+	endpoints, err := c.provider.ListEndpoints(ctx, target.PoolID)
+	if err != nil {
+		return err
+	}
+
+	for _, ep := range endpoints {
+		if ep.Address == target.Address {
+			ep.Disabled = disabled
+			return c.provider.UpdateEndpoint(ctx, target.PoolID, ep)
+		}
+	}
+	return fmt.Errorf("endpoint %s not found in pool %s", target.Address, target.PoolID)
+}
+
+// resolveTargets 클러스터에 연결된 활성 엔드포인트 조회
+// FAILOVER_ENDPOINTS 매핑이 없으면 이름에 클러스터 ID가 포함된 풀의 엔드포인트를 사용
+func (c *Controller) resolveTargets(ctx context.Context, clusterID string) ([]Target, error) {
+	g, err := gslb.FindGSLB(ctx, c.provider, c.gslbName)
+	if err != nil {
+		return nil, err
+	}
+
+	simulated := c.simulated()
+	mapped, hasMapping := c.mapping[clusterID]
+	targets := make([]Target, 0)
+	for _, cp := range g.Pools {
+		for _, ep := range cp.Pool.Endpoints {
+			if ep.Disabled || simulated[Target{PoolID: cp.Pool.ID, Address: ep.Address}] {
+				continue
+			}
+
+			matched := false
+			if hasMapping {
+				for _, t := range mapped {
+					if t.Address == ep.Address && (t.PoolID == "" || t.PoolID == cp.Pool.ID) {
+						matched = true
+						break
+					}
+				}
+			} else {
+				matched = strings.Contains(strings.ToLower(cp.Pool.Name), strings.ToLower(clusterID))
+			}
+
+			if matched {
+				targets = append(targets, Target{PoolID: cp.Pool.ID, Address: ep.Address})
+			}
+		}
+	}
+	return targets, nil
+}
+
+// enabledEndpointCount GSLB 전체에서 활성 엔드포인트 수
+// dry-run이면 비활성화한 것으로 간주한 엔드포인트를 빼서 enforce와 같은 판단을 보고
+func (c *Controller) enabledEndpointCount(ctx context.Context) (int, error) {
+	g, err := gslb.FindGSLB(ctx, c.provider, c.gslbName)
+	if err != nil {
+		return 0, err
+	}
+
+	simulated := c.simulated()
+	count := 0
+	for _, cp := range g.Pools {
+		if cp.Pool.Disabled {
+			continue
+		}
+		for _, ep := range cp.Pool.Endpoints {
+			if !ep.Disabled && !simulated[Target{PoolID: cp.Pool.ID, Address: ep.Address}] {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+// simulated dry-run에서 비활성화한 것으로 간주하는 엔드포인트 (enforce면 nil)
+func (c *Controller) simulated() map[Target]bool {
+	if c.rules.Mode != ModeDryRun {
+		return nil
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	targets := make(map[Target]bool)
+	for _, state := range c.clusters {
+		for _, target := range state.Targets {
+			targets[target] = true
+		}
+	}
+	return targets
+}
+
+// report auto 이벤트 기록 (dry-run이면 표시)
+func (c *Controller) report(clusterID string, target *Target, message string) {
+	if c.rules.Mode == ModeDryRun {
+		message = "[DRY-RUN] " + message
+	}
+	log.Printf("[Failover] %s", message)
+
+	event := eventlog.Event{
+		Type:       "auto",
+		Message:    message,
+		Source:     "failover",
+		ClusterID:  clusterID,
+		Attributes: map[string]string{"mode": c.rules.Mode},
+	}
+	if target != nil {
+		event.InvolvedObject = target.objectRef()
+		event.Attributes["poolId"] = target.PoolID
+	}
+	c.eventLog.Record(event)
+}
+
+// copy 외부로 전달할 복사본
+func (s *ClusterStatus) copy() ClusterStatus {
+	out := *s
+	out.Targets = append([]Target{}, s.Targets...)
+	return out
+}
+
+// objectRef 이벤트용 리소스 참조
+func (t Target) objectRef() *eventlog.ObjectReference {
+	return &eventlog.ObjectReference{Kind: "GSLBEndpoint", Name: t.Address}
+}
+
+// clusterState 클러스터 상태 조회 (없으면 생성)
+func (c *Controller) clusterState(clusterID string) *ClusterStatus {
+	state, exists := c.clusters[clusterID]
+	if !exists {
+		state = &ClusterStatus{ClusterID: clusterID}
+		c.clusters[clusterID] = state
+	}
+	return state
+}
+
+// parseMapping "member1=poolA/10.0.0.1,member1=10.0.0.2,member2=poolB/10.0.0.3" 형식 파싱
+func parseMapping(value string) map[string][]Target {
+	mapping := make(map[string][]Target)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		clusterID, endpoint, ok := strings.Cut(entry, "=")
+		if !ok {
+			log.Printf("Warning: invalid FAILOVER_ENDPOINTS entry %q", entry)
+			continue
+		}
+
+		target := Target{Address: endpoint}
+		if poolID, address, hasPool := strings.Cut(endpoint, "/"); hasPool {
+			target = Target{PoolID: poolID, Address: address}
+		}
+		mapping[clusterID] = append(mapping[clusterID], target)
+	}
+	return mapping
+}
+
+// getEnv 환경변수 조회 (없으면 기본값)
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// getDurationEnv 기간 환경변수 조회
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Warning: invalid %s %q, using %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return d
+}
+
+// getIntEnv 정수 환경변수 조회
+func getIntEnv(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Warning: invalid %s %q, using %d", key, value, defaultValue)
+		return defaultValue
+	}
+	return n
+}
+
+// timePtr 시간 포인터 생성
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
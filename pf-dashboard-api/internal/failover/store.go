@@ -0,0 +1,86 @@
+package failover
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// isolatedBucket 격리 대상 버킷 이름 (키: 클러스터 ID)
+var isolatedBucket = []byte("isolated")
+
+// Store bbolt 파일 기반 격리 상태 저장소
+// 재시작 후에도 컨트롤러가 비활성화한 엔드포인트를 기억해 복구 시 재활성화하고 교정 대상에서 제외
+type Store struct {
+	db *bolt.DB
+}
+
+// OpenStore 격리 상태 저장소 열기 (파일과 상위 디렉터리가 없으면 생성)
+func OpenStore(path string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create failover store directory: %w", err)
+	}
+
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open failover store: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(isolatedBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize failover store: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Save 클러스터의 격리 대상 저장 (비어 있으면 삭제)
+func (s *Store) Save(clusterID string, targets []Target) error {
+	if len(targets) == 0 {
+		return s.db.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket(isolatedBucket).Delete([]byte(clusterID))
+		})
+	}
+
+	data, err := json.Marshal(targets)
+	if err != nil {
+		return fmt.Errorf("failed to encode targets: %w", err)
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(isolatedBucket).Put([]byte(clusterID), data)
+	})
+}
+
+// Load 클러스터별 격리 대상 조회
+func (s *Store) Load() (map[string][]Target, error) {
+	isolated := make(map[string][]Target)
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(isolatedBucket).ForEach(func(k, v []byte) error {
+			var targets []Target
+			if err := json.Unmarshal(v, &targets); err != nil {
+				return fmt.Errorf("failed to decode targets of %s: %w", k, err)
+			}
+			isolated[string(k)] = targets
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return isolated, nil
+}
+
+// Close 저장소 닫기
+func (s *Store) Close() error {
+	return s.db.Close()
+}
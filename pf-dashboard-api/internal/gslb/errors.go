@@ -0,0 +1,182 @@
+package gslb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// 공급자 오류 종류 (errors.Is로 판별)
+var (
+	ErrNotConfigured       = errors.New("gslb provider is not configured")
+	ErrNotFound            = errors.New("gslb resource not found")
+	ErrUpstreamUnavailable = errors.New("gslb upstream unavailable")
+	ErrAuthFailed          = errors.New("gslb upstream authentication failed")
+)
+
+// Error 공급자 API 오류
+// Kind는 위 오류 종류 중 하나이며, 분류할 수 없는 요청 거부는 nil
+type Error struct {
+	Kind       error
+	Op         string // 예: "GET /gslbs"
+	StatusCode int    // 상위 API HTTP 상태 코드 (응답이 없으면 0)
+	Timeout    bool   // 요청 타임아웃 여부
+	Message    string
+	Err        error
+}
+
+// Error 오류 메시지
+func (e *Error) Error() string {
+	msg := e.Message
+	if msg == "" && e.Err != nil {
+		msg = e.Err.Error()
+	}
+	if msg == "" && e.Kind != nil {
+		msg = e.Kind.Error()
+	}
+	if e.Op == "" {
+		return msg
+	}
+	return e.Op + ": " + msg
+}
+
+// Unwrap 원인 오류 반환
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is 오류 종류 비교
+func (e *Error) Is(target error) bool {
+	return e.Kind != nil && e.Kind == target
+}
+
+// notFoundf 리소스 없음 오류 생성
+func notFoundf(format string, args ...interface{}) error {
+	return &Error{Kind: ErrNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+// notConfigured 설정 누락 오류 생성
+func notConfigured(message string) error {
+	return &Error{Kind: ErrNotConfigured, Message: message}
+}
+
+// statusError 상위 API HTTP 상태 코드를 오류 종류로 분류
+func statusError(op string, statusCode int, body string) error {
+	e := &Error{Op: op, StatusCode: statusCode, Message: fmt.Sprintf("API returned status %d: %s", statusCode, body)}
+
+	switch {
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		e.Kind = ErrAuthFailed
+	case statusCode == http.StatusNotFound:
+		e.Kind = ErrNotFound
+	case statusCode == http.StatusTooManyRequests || statusCode >= 500:
+		e.Kind = ErrUpstreamUnavailable
+	}
+	return e
+}
+
+// transportError 요청 전송 실패를 upstream unavailable로 분류
+func transportError(op string, err error) error {
+	return &Error{
+		Kind:    ErrUpstreamUnavailable,
+		Op:      op,
+		Timeout: errors.Is(err, context.DeadlineExceeded) || isTimeout(err),
+		Message: "failed to execute request: " + err.Error(),
+		Err:     err,
+	}
+}
+
+// isTimeout net.Error 타임아웃 여부
+func isTimeout(err error) bool {
+	var te interface{ Timeout() bool }
+	return errors.As(err, &te) && te.Timeout()
+}
+
+// HTTPStatus 공급자 오류에 대응하는 API 응답 상태 코드
+func HTTPStatus(err error) int {
+	var e *Error
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrNotConfigured):
+		return http.StatusServiceUnavailable
+	case errors.As(err, &e) && e.Timeout:
+		return http.StatusGatewayTimeout
+	case errors.Is(err, ErrUpstreamUnavailable), errors.Is(err, ErrAuthFailed):
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// retryPolicy 상위 API 재시도 정책 (지수 백오프 + full jitter)
+type retryPolicy struct {
+	maxRetries     int
+	baseDelay      time.Duration
+	maxDelay       time.Duration
+	attemptTimeout time.Duration
+}
+
+// retryPolicyFromEnv GSLB_MAX_RETRIES, GSLB_RETRY_BASE_DELAY, GSLB_REQUEST_TIMEOUT 환경변수로 정책 생성
+func retryPolicyFromEnv() retryPolicy {
+	return retryPolicy{
+		maxRetries:     envInt("GSLB_MAX_RETRIES", 3),
+		baseDelay:      envDuration("GSLB_RETRY_BASE_DELAY", 500*time.Millisecond),
+		maxDelay:       10 * time.Second,
+		attemptTimeout: envDuration("GSLB_REQUEST_TIMEOUT", 10*time.Second),
+	}
+}
+
+// run attempt를 실행하고 upstream unavailable 오류면 백오프 후 재시도
+// 호출자의 context가 끝나면 즉시 중단
+func (p retryPolicy) run(ctx context.Context, attempt func(ctx context.Context) error) error {
+	var err error
+	for i := 0; ; i++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, p.attemptTimeout)
+		err = attempt(attemptCtx)
+		cancel()
+
+		if err == nil || !errors.Is(err, ErrUpstreamUnavailable) || i >= p.maxRetries || ctx.Err() != nil {
+			return err
+		}
+
+		delay := p.backoff(i)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff i번째 재시도 전 대기 시간 (0 ~ min(maxDelay, baseDelay*2^i) 균등 분포)
+func (p retryPolicy) backoff(i int) time.Duration {
+	ceiling := p.baseDelay << uint(i)
+	if ceiling <= 0 || ceiling > p.maxDelay {
+		ceiling = p.maxDelay
+	}
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+// envInt 정수 환경변수 조회
+func envInt(key string, defaultValue int) int {
+	if n, err := strconv.Atoi(os.Getenv(key)); err == nil && n >= 0 {
+		return n
+	}
+	return defaultValue
+}
+
+// envDuration 기간 환경변수 조회
+func envDuration(key string, defaultValue time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(key)); err == nil && d > 0 {
+		return d
+	}
+	return defaultValue
+}
@@ -0,0 +1,220 @@
+package gslb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+
+	"sigs.k8s.io/yaml"
+)
+
+// FileProvider YAML(또는 JSON) 파일 기반 GSLB 공급자
+// 테스트와 데모용으로 실제 DNS 없이 GSLB 상태를 흉내냄
+type FileProvider struct {
+	path string
+	mu   sync.Mutex
+}
+
+// fileState 파일에 저장되는 GSLB 상태
+//
+//	gslbs:
+//	  - id: gslb-1
+//	    name: karmada
+//	    domain: karmada.example.com
+//	    ttl: 30
+//	    routingRule: FAILOVER
+//	    pools:
+//	      - order: 1
+//	        pool:
+//	          id: pool-member1
+//	          name: member1
+//	          endpoints:
+//	            - address: 10.0.0.1
+//	              weight: 1
+//	health:
+//	  - poolId: pool-member1
+//	    address: 10.0.0.1
+//	    status: healthy
+type fileState struct {
+	GSLBs  []GSLB           `json:"gslbs"`
+	Health []EndpointHealth `json:"health,omitempty"`
+}
+
+// NewFileProvider 새 파일 기반 공급자 생성
+func NewFileProvider(path string) *FileProvider {
+	if _, err := os.Stat(path); err != nil {
+		log.Printf("Warning: GSLB file %s is not readable: %v", path, err)
+	}
+
+	return &FileProvider{path: path}
+}
+
+// Name 공급자 이름
+func (f *FileProvider) Name() string {
+	return "file"
+}
+
+// ListGSLBs GSLB 목록 조회 (매 호출마다 파일을 다시 읽어 수동 편집을 반영)
+func (f *FileProvider) ListGSLBs(ctx context.Context) ([]GSLB, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	state, err := f.load()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range state.GSLBs {
+		state.GSLBs[i].Provider = f.Name()
+	}
+	return state.GSLBs, nil
+}
+
+// ListPools 풀 목록 조회 (여러 GSLB에 연결된 풀은 한 번만 반환)
+func (f *FileProvider) ListPools(ctx context.Context) ([]Pool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	state, err := f.load()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	pools := make([]Pool, 0)
+	for _, g := range state.GSLBs {
+		for _, cp := range g.Pools {
+			if seen[cp.Pool.ID] {
+				continue
+			}
+			seen[cp.Pool.ID] = true
+			pools = append(pools, cp.Pool)
+		}
+	}
+	return pools, nil
+}
+
+// ListEndpoints 특정 풀의 엔드포인트 목록 조회
+func (f *FileProvider) ListEndpoints(ctx context.Context, poolID string) ([]Endpoint, error) {
+	pools, err := f.ListPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range pools {
+		if p.ID == poolID {
+			return p.Endpoints, nil
+		}
+	}
+	return nil, notFoundf("pool '%s' not found", poolID)
+}
+
+// GetHealth 엔드포인트 헬스 상태 조회
+// 파일의 health 항목을 우선 사용하고, 없으면 활성 엔드포인트를 healthy로 간주
+func (f *FileProvider) GetHealth(ctx context.Context) ([]EndpointHealth, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	state, err := f.load()
+	if err != nil {
+		return nil, err
+	}
+
+	declared := make(map[string]EndpointHealth)
+	for _, h := range state.Health {
+		declared[h.PoolID+"/"+h.Address] = h
+	}
+
+	seen := make(map[string]bool)
+	health := make([]EndpointHealth, 0)
+	for _, g := range state.GSLBs {
+		for _, cp := range g.Pools {
+			for _, ep := range cp.Pool.Endpoints {
+				key := cp.Pool.ID + "/" + ep.Address
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				h, ok := declared[key]
+				if !ok {
+					h = EndpointHealth{PoolID: cp.Pool.ID, Address: ep.Address, Status: HealthHealthy}
+				}
+				if cp.Pool.Disabled || ep.Disabled {
+					h.Status = HealthDisabled
+				}
+				health = append(health, h)
+			}
+		}
+	}
+	return health, nil
+}
+
+// UpdateEndpoint 엔드포인트 가중치/활성 상태 변경 후 파일에 저장
+func (f *FileProvider) UpdateEndpoint(ctx context.Context, poolID string, endpoint Endpoint) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	state, err := f.load()
+	if err != nil {
+		return err
+	}
+
+	// 같은 풀이 여러 GSLB에 연결될 수 있으므로 모든 위치를 갱신
+	updated := false
+	for gi := range state.GSLBs {
+		for pi := range state.GSLBs[gi].Pools {
+			pool := &state.GSLBs[gi].Pools[pi].Pool
+			if pool.ID != poolID {
+				continue
+			}
+			idx := findEndpoint(pool.Endpoints, endpoint.Address)
+			if idx < 0 {
+				continue
+			}
+			pool.Endpoints[idx].Weight = endpoint.Weight
+			pool.Endpoints[idx].Disabled = endpoint.Disabled
+			updated = true
+		}
+	}
+	if !updated {
+		return notFoundf("endpoint %s not found in pool %s", endpoint.Address, poolID)
+	}
+
+	if err := f.save(state); err != nil {
+		return err
+	}
+
+	log.Printf("[GSLB] Updated endpoint %s in pool %s (weight: %v, disabled: %v)",
+		endpoint.Address, poolID, endpoint.Weight, endpoint.Disabled)
+	return nil
+}
+
+// load 파일에서 상태 읽기
+func (f *FileProvider) load() (*fileState, error) {
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GSLB file: %w", err)
+	}
+
+	var state fileState
+	if err := yaml.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse GSLB file: %w", err)
+	}
+	return &state, nil
+}
+
+// save 상태를 파일에 저장
+func (f *FileProvider) save(state *fileState) error {
+	data, err := yaml.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode GSLB file: %w", err)
+	}
+
+	if err := os.WriteFile(f.path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write GSLB file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,463 @@
+package gslb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// NHNProvider NHN Cloud DNS Plus API 기반 GSLB 공급자
+type NHNProvider struct {
+	baseURL string
+	appKey  string
+	client  *http.Client
+	retry   retryPolicy
+}
+
+// nhnEndpoint DNS Plus 엔드포인트 응답 형식
+type nhnEndpoint struct {
+	EndpointID       string  `json:"endpointId,omitempty"`
+	EndpointAddress  string  `json:"endpointAddress"`
+	EndpointWeight   float64 `json:"endpointWeight"`
+	EndpointDisabled bool    `json:"endpointDisabled"`
+	// 헬스체크가 연결된 풀에서만 채워짐
+	HealthCheckStatus string `json:"healthCheckStatus,omitempty"`
+}
+
+// nhnPool DNS Plus 풀 응답 형식
+type nhnPool struct {
+	PoolID        string        `json:"poolId,omitempty"`
+	PoolName      string        `json:"poolName"`
+	PoolDisabled  bool          `json:"poolDisabled"`
+	HealthCheckID string        `json:"healthCheckId,omitempty"`
+	EndpointList  []nhnEndpoint `json:"endpointList"`
+	CreatedAt     string        `json:"createdAt,omitempty"`
+	UpdatedAt     string        `json:"updatedAt,omitempty"`
+}
+
+// nhnConnectedPool DNS Plus 연결 풀 응답 형식
+type nhnConnectedPool struct {
+	PoolID                     string  `json:"poolId"`
+	ConnectedPoolOrder         int     `json:"connectedPoolOrder"`
+	ConnectedPoolRegionContent string  `json:"connectedPoolRegionContent"`
+	Pool                       nhnPool `json:"pool"`
+}
+
+// nhnGSLB DNS Plus GSLB 응답 형식
+type nhnGSLB struct {
+	GslbID            string             `json:"gslbId"`
+	GslbName          string             `json:"gslbName"`
+	GslbDomain        string             `json:"gslbDomain"`
+	GslbTTL           int                `json:"gslbTtl"`
+	GslbRoutingRule   string             `json:"gslbRoutingRule"`
+	GslbDisabled      bool               `json:"gslbDisabled"`
+	ConnectedPoolList []nhnConnectedPool `json:"connectedPoolList"`
+	CreatedAt         string             `json:"createdAt"`
+	UpdatedAt         string             `json:"updatedAt"`
+}
+
+// nhnHeader DNS Plus 공통 응답 헤더
+type nhnHeader struct {
+	IsSuccessful  bool   `json:"isSuccessful"`
+	ResultCode    int    `json:"resultCode"`
+	ResultMessage string `json:"resultMessage"`
+}
+
+// nhnGSLBResponse GSLB 목록 응답
+type nhnGSLBResponse struct {
+	Header     nhnHeader `json:"header"`
+	TotalCount int       `json:"totalCount"`
+	GslbList   []nhnGSLB `json:"gslbList"`
+}
+
+// nhnPoolResponse 풀 목록 응답
+type nhnPoolResponse struct {
+	Header     nhnHeader `json:"header"`
+	TotalCount int       `json:"totalCount"`
+	PoolList   []nhnPool `json:"poolList"`
+}
+
+// nhnHeaderResponse 헤더만 확인하는 응답 (수정 API)
+type nhnHeaderResponse struct {
+	Header nhnHeader `json:"header"`
+}
+
+// headerOf 응답 헤더 추출
+func (r *nhnGSLBResponse) headerOf() nhnHeader   { return r.Header }
+func (r *nhnPoolResponse) headerOf() nhnHeader   { return r.Header }
+func (r *nhnHeaderResponse) headerOf() nhnHeader { return r.Header }
+
+// nhnEnvelope DNS Plus 응답 공통 인터페이스
+type nhnEnvelope interface {
+	headerOf() nhnHeader
+}
+
+// NewNHNProvider 새 NHN Cloud DNS Plus 공급자 생성
+func NewNHNProvider() *NHNProvider {
+	baseURL := os.Getenv("GSLB_API_URL")
+	if baseURL == "" {
+		baseURL = "https://dnsplus.api.nhncloudservice.com"
+	}
+
+	appKey := os.Getenv("GSLB_APP_KEY")
+	if appKey == "" {
+		log.Printf("Warning: GSLB_APP_KEY not set")
+	}
+
+	return &NHNProvider{
+		baseURL: baseURL,
+		appKey:  appKey,
+		// 요청별 타임아웃은 retry 정책이 context로 적용
+		client: &http.Client{},
+		retry:  retryPolicyFromEnv(),
+	}
+}
+
+// Name 공급자 이름
+func (c *NHNProvider) Name() string {
+	return "nhn"
+}
+
+// ListGSLBs GSLB 목록 조회
+func (c *NHNProvider) ListGSLBs(ctx context.Context) ([]GSLB, error) {
+	var resp nhnGSLBResponse
+	if err := c.do(ctx, http.MethodGet, "/gslbs", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[GSLB] Successfully fetched %d GSLBs", len(resp.GslbList))
+
+	gslbs := make([]GSLB, 0, len(resp.GslbList))
+	for i, g := range resp.GslbList {
+		log.Printf("[GSLB] #%d - Name: '%s', Domain: '%s', ID: '%s'",
+			i+1, g.GslbName, g.GslbDomain, g.GslbID)
+		gslbs = append(gslbs, g.toGSLB())
+	}
+
+	return gslbs, nil
+}
+
+// ListPools 풀 목록 조회
+func (c *NHNProvider) ListPools(ctx context.Context) ([]Pool, error) {
+	nhnPools, err := c.listNHNPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]Pool, 0, len(nhnPools))
+	for _, p := range nhnPools {
+		pools = append(pools, p.toPool())
+	}
+	return pools, nil
+}
+
+// ListEndpoints 특정 풀의 엔드포인트 목록 조회
+func (c *NHNProvider) ListEndpoints(ctx context.Context, poolID string) ([]Endpoint, error) {
+	pool, err := c.getNHNPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	return pool.toPool().Endpoints, nil
+}
+
+// GetHealth 모든 엔드포인트의 헬스 상태 조회
+func (c *NHNProvider) GetHealth(ctx context.Context) ([]EndpointHealth, error) {
+	nhnPools, err := c.listNHNPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	health := make([]EndpointHealth, 0)
+	for _, p := range nhnPools {
+		for _, ep := range p.EndpointList {
+			h := EndpointHealth{
+				PoolID:  p.PoolID,
+				Address: ep.EndpointAddress,
+				Status:  HealthUnknown,
+				Detail:  ep.HealthCheckStatus,
+			}
+
+			switch {
+			case p.PoolDisabled || ep.EndpointDisabled:
+				h.Status = HealthDisabled
+			case ep.HealthCheckStatus == "":
+				// 헬스체크 미연결: 상태 판단 불가
+			case ep.HealthCheckStatus == "HEALTHY" || ep.HealthCheckStatus == "NORMAL":
+				h.Status = HealthHealthy
+			default:
+				h.Status = HealthUnhealthy
+			}
+
+			health = append(health, h)
+		}
+	}
+
+	return health, nil
+}
+
+// UpdateEndpoint 풀 안의 엔드포인트 가중치/활성 상태 변경
+// DNS Plus는 풀 단위 수정만 지원하므로 풀 전체를 조회 후 다시 저장
+// PUT만 재시도하면 그 사이의 다른 변경(Failover 컨트롤러, GSLB 교정)을 덮어쓸 수 있으므로 시도마다 풀을 다시 조회
+func (c *NHNProvider) UpdateEndpoint(ctx context.Context, poolID string, endpoint Endpoint) error {
+	if c.appKey == "" {
+		return notConfigured("GSLB_APP_KEY is not configured")
+	}
+
+	attempts := 0
+	err := c.retry.run(ctx, func(ctx context.Context) error {
+		attempts++
+		if attempts > 1 {
+			log.Printf("[GSLB] Retrying update of endpoint %s in pool %s (attempt %d)", endpoint.Address, poolID, attempts)
+		}
+		return c.updateEndpointOnce(ctx, poolID, endpoint)
+	})
+	if err != nil {
+		return c.redactError(err)
+	}
+
+	log.Printf("[GSLB] Updated endpoint %s in pool %s (weight: %v, disabled: %v)",
+		endpoint.Address, poolID, endpoint.Weight, endpoint.Disabled)
+	return nil
+}
+
+// updateEndpointOnce 풀 조회, 엔드포인트 변경, 풀 저장 1회 실행
+func (c *NHNProvider) updateEndpointOnce(ctx context.Context, poolID string, endpoint Endpoint) error {
+	var list nhnPoolResponse
+	if err := c.doOnce(ctx, http.MethodGet, "/pools", nil, &list); err != nil {
+		return err
+	}
+	pool, err := findNHNPool(list.PoolList, poolID)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i := range pool.EndpointList {
+		if pool.EndpointList[i].EndpointAddress == endpoint.Address {
+			pool.EndpointList[i].EndpointWeight = endpoint.Weight
+			pool.EndpointList[i].EndpointDisabled = endpoint.Disabled
+			found = true
+			break
+		}
+	}
+	if !found {
+		return notFoundf("endpoint %s not found in pool %s", endpoint.Address, poolID)
+	}
+
+	// 수정 요청에는 읽기 전용 필드를 제외
+	update := nhnPool{
+		PoolName:      pool.PoolName,
+		PoolDisabled:  pool.PoolDisabled,
+		HealthCheckID: pool.HealthCheckID,
+		EndpointList:  make([]nhnEndpoint, 0, len(pool.EndpointList)),
+	}
+	for _, ep := range pool.EndpointList {
+		update.EndpointList = append(update.EndpointList, nhnEndpoint{
+			EndpointAddress:  ep.EndpointAddress,
+			EndpointWeight:   ep.EndpointWeight,
+			EndpointDisabled: ep.EndpointDisabled,
+		})
+	}
+
+	data, err := json.Marshal(map[string]nhnPool{"pool": update})
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+	var resp nhnHeaderResponse
+	return c.doOnce(ctx, http.MethodPut, "/pools/"+poolID, data, &resp)
+}
+
+// listNHNPools DNS Plus 풀 목록 원본 조회
+func (c *NHNProvider) listNHNPools(ctx context.Context) ([]nhnPool, error) {
+	var resp nhnPoolResponse
+	if err := c.do(ctx, http.MethodGet, "/pools", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.PoolList, nil
+}
+
+// getNHNPool 특정 풀 원본 조회
+func (c *NHNProvider) getNHNPool(ctx context.Context, poolID string) (*nhnPool, error) {
+	pools, err := c.listNHNPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return findNHNPool(pools, poolID)
+}
+
+// findNHNPool 풀 목록에서 ID로 풀 찾기
+func findNHNPool(pools []nhnPool, poolID string) (*nhnPool, error) {
+	for _, p := range pools {
+		if p.PoolID == poolID {
+			return &p, nil
+		}
+	}
+	return nil, notFoundf("pool '%s' not found", poolID)
+}
+
+// do DNS Plus API 요청 실행 및 공통 헤더 검사
+// 조회(GET)의 5xx/429 응답과 전송 실패는 재시도 정책에 따라 재시도하며, 반환되는 오류에서 app key를 가림
+// 수정 요청은 재시도하지 않음 (조회부터 다시 해야 하는 경우 호출자가 재시도)
+func (c *NHNProvider) do(ctx context.Context, method, path string, reqBody interface{}, out nhnEnvelope) error {
+	if c.appKey == "" {
+		return notConfigured("GSLB_APP_KEY is not configured")
+	}
+
+	var data []byte
+	if reqBody != nil {
+		var err error
+		data, err = json.Marshal(reqBody)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+
+	policy := c.retry
+	if method != http.MethodGet {
+		policy.maxRetries = 0
+	}
+
+	op := method + " " + path
+	attempts := 0
+	err := policy.run(ctx, func(ctx context.Context) error {
+		attempts++
+		if attempts > 1 {
+			log.Printf("[GSLB] Retrying %s (attempt %d)", op, attempts)
+		}
+		return c.doOnce(ctx, method, path, data, out)
+	})
+	if err != nil {
+		return c.redactError(err)
+	}
+	return nil
+}
+
+// doOnce DNS Plus API 요청 1회 실행
+func (c *NHNProvider) doOnce(ctx context.Context, method, path string, data []byte, out nhnEnvelope) error {
+	op := method + " " + path
+	url := fmt.Sprintf("%s/dnsplus/v1.0/appkeys/%s%s", c.baseURL, c.appKey, path)
+
+	var bodyReader io.Reader
+	if data != nil {
+		bodyReader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return &Error{Op: op, Message: "failed to create request", Err: err}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return transportError(op, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return transportError(op, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return statusError(op, resp.StatusCode, string(body))
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return &Error{Op: op, Message: "failed to parse response", Err: err}
+	}
+
+	header := out.headerOf()
+	if !header.IsSuccessful {
+		return &Error{Op: op, Message: fmt.Sprintf("API error: %s (code: %d)", header.ResultMessage, header.ResultCode)}
+	}
+
+	return nil
+}
+
+// redactError 오류 메시지에 포함될 수 있는 app key를 가린 오류 반환 (오류 종류는 유지)
+func (c *NHNProvider) redactError(err error) error {
+	msg := err.Error()
+	if !strings.Contains(msg, c.appKey) {
+		return err
+	}
+
+	redacted := &Error{Message: c.redact(msg)}
+	var e *Error
+	if errors.As(err, &e) {
+		redacted.Kind = e.Kind
+		redacted.StatusCode = e.StatusCode
+		redacted.Timeout = e.Timeout
+	}
+	return redacted
+}
+
+// redact 문자열에서 app key 가리기
+func (c *NHNProvider) redact(s string) string {
+	if c.appKey == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, c.appKey, "[REDACTED]")
+}
+
+// toGSLB DNS Plus GSLB를 중립 형식으로 변환
+func (g nhnGSLB) toGSLB() GSLB {
+	result := GSLB{
+		ID:          g.GslbID,
+		Name:        g.GslbName,
+		Domain:      g.GslbDomain,
+		TTL:         g.GslbTTL,
+		RoutingRule: g.GslbRoutingRule,
+		Disabled:    g.GslbDisabled,
+		Pools:       make([]ConnectedPool, 0, len(g.ConnectedPoolList)),
+		Provider:    "nhn",
+		CreatedAt:   g.CreatedAt,
+		UpdatedAt:   g.UpdatedAt,
+	}
+
+	for _, cp := range g.ConnectedPoolList {
+		pool := cp.Pool.toPool()
+		if pool.ID == "" {
+			pool.ID = cp.PoolID
+		}
+		result.Pools = append(result.Pools, ConnectedPool{
+			Order:         cp.ConnectedPoolOrder,
+			RegionContent: cp.ConnectedPoolRegionContent,
+			Pool:          pool,
+		})
+	}
+
+	return result
+}
+
+// toPool DNS Plus 풀을 중립 형식으로 변환
+func (p nhnPool) toPool() Pool {
+	pool := Pool{
+		ID:            p.PoolID,
+		Name:          p.PoolName,
+		Disabled:      p.PoolDisabled,
+		HealthCheckID: p.HealthCheckID,
+		Endpoints:     make([]Endpoint, 0, len(p.EndpointList)),
+		CreatedAt:     p.CreatedAt,
+		UpdatedAt:     p.UpdatedAt,
+	}
+
+	for _, ep := range p.EndpointList {
+		pool.Endpoints = append(pool.Endpoints, Endpoint{
+			ID:       ep.EndpointID,
+			Address:  ep.EndpointAddress,
+			Weight:   ep.EndpointWeight,
+			Disabled: ep.EndpointDisabled,
+		})
+	}
+
+	return pool
+}
@@ -0,0 +1,168 @@
+package gslb
+
+import (
+	"context"
+	"log"
+	"os"
+	"strings"
+)
+
+// 라우팅 규칙 (provider 중립)
+const (
+	RoutingFailover    = "FAILOVER"    // 우선순위 순서대로 응답
+	RoutingRandom      = "RANDOM"      // 가중치 기반 랜덤 응답
+	RoutingGeolocation = "GEOLOCATION" // 클라이언트 지역 기반 응답
+)
+
+// 엔드포인트 헬스 상태
+const (
+	HealthHealthy   = "healthy"
+	HealthUnhealthy = "unhealthy"
+	HealthDisabled  = "disabled"
+	HealthUnknown   = "unknown"
+)
+
+// Endpoint GSLB 엔드포인트 정보
+type Endpoint struct {
+	ID       string  `json:"id,omitempty"`
+	Address  string  `json:"address"`
+	Weight   float64 `json:"weight"`
+	Disabled bool    `json:"disabled"`
+}
+
+// Pool GSLB 풀 정보
+type Pool struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Disabled      bool       `json:"disabled"`
+	HealthCheckID string     `json:"healthCheckId,omitempty"`
+	Endpoints     []Endpoint `json:"endpoints"`
+	CreatedAt     string     `json:"createdAt,omitempty"`
+	UpdatedAt     string     `json:"updatedAt,omitempty"`
+}
+
+// ConnectedPool GSLB에 연결된 풀 정보
+type ConnectedPool struct {
+	Order         int    `json:"order"`         // FAILOVER 우선순위 (낮을수록 우선)
+	RegionContent string `json:"regionContent"` // GEOLOCATION 대상 지역
+	Pool          Pool   `json:"pool"`
+}
+
+// GSLB GSLB 정보
+type GSLB struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Domain      string          `json:"domain"`
+	TTL         int             `json:"ttl"`
+	RoutingRule string          `json:"routingRule"`
+	Disabled    bool            `json:"disabled"`
+	Pools       []ConnectedPool `json:"pools"`
+	Provider    string          `json:"provider,omitempty"`
+	CreatedAt   string          `json:"createdAt,omitempty"`
+	UpdatedAt   string          `json:"updatedAt,omitempty"`
+}
+
+// EndpointHealth 엔드포인트 헬스 상태
+type EndpointHealth struct {
+	PoolID  string `json:"poolId"`
+	Address string `json:"address"`
+	Status  string `json:"status"` // healthy, unhealthy, disabled, unknown
+	Detail  string `json:"detail,omitempty"`
+}
+
+// PoolDetail GSLB 풀 상세 정보 (UI용)
+type PoolDetail struct {
+	Pool      Pool       `json:"pool"`
+	Endpoints []Endpoint `json:"endpoints"`
+}
+
+// Provider GSLB 공급자 인터페이스
+// NHN Cloud DNS Plus, Route 53 스타일 API, 로컬 파일 등 백엔드별로 구현
+type Provider interface {
+	// Name 공급자 이름 (nhn, route53, file)
+	Name() string
+	// ListGSLBs GSLB 목록 조회
+	ListGSLBs(ctx context.Context) ([]GSLB, error)
+	// ListPools 풀 목록 조회
+	ListPools(ctx context.Context) ([]Pool, error)
+	// ListEndpoints 특정 풀의 엔드포인트 목록 조회
+	ListEndpoints(ctx context.Context, poolID string) ([]Endpoint, error)
+	// GetHealth 모든 엔드포인트의 헬스 상태 조회
+	GetHealth(ctx context.Context) ([]EndpointHealth, error)
+	// UpdateEndpoint 풀 안의 엔드포인트(주소 기준) 가중치/활성 상태 변경
+	UpdateEndpoint(ctx context.Context, poolID string, endpoint Endpoint) error
+}
+
+// NewProviderFromEnv 환경변수(GSLB_PROVIDER)에 따라 GSLB 공급자 생성
+func NewProviderFromEnv() Provider {
+	providerName := strings.ToLower(os.Getenv("GSLB_PROVIDER"))
+
+	switch providerName {
+	case "file":
+		path := os.Getenv("GSLB_FILE")
+		if path == "" {
+			path = "gslb.yaml"
+		}
+		log.Printf("[GSLB] Using file provider: %s", path)
+		return NewFileProvider(path)
+	case "route53":
+		log.Printf("[GSLB] Using Route 53 provider")
+		return NewRoute53Provider()
+	case "", "nhn":
+		log.Printf("[GSLB] Using NHN Cloud DNS Plus provider")
+		return NewNHNProvider()
+	default:
+		log.Printf("Warning: unknown GSLB_PROVIDER %q, falling back to nhn", providerName)
+		return NewNHNProvider()
+	}
+}
+
+// FindGSLB 특정 이름의 GSLB 조회
+func FindGSLB(ctx context.Context, p Provider, name string) (*GSLB, error) {
+	gslbs, err := p.ListGSLBs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, g := range gslbs {
+		if g.Name == name {
+			log.Printf("[GSLB] Found GSLB: %s (%s)", g.Name, g.Domain)
+			return &g, nil
+		}
+	}
+
+	return nil, notFoundf("GSLB with name '%s' not found", name)
+}
+
+// ListPoolDetails 모든 GSLB에 연결된 풀의 상세 정보 조회
+func ListPoolDetails(ctx context.Context, p Provider) ([]PoolDetail, error) {
+	gslbs, err := p.ListGSLBs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]PoolDetail, 0)
+	for _, g := range gslbs {
+		for _, connectedPool := range g.Pools {
+			details = append(details, PoolDetail{
+				Pool:      connectedPool.Pool,
+				Endpoints: connectedPool.Pool.Endpoints,
+			})
+
+			log.Printf("[GSLB] Pool %s (%s) has %d endpoints",
+				connectedPool.Pool.Name, connectedPool.Pool.ID, len(connectedPool.Pool.Endpoints))
+		}
+	}
+
+	return details, nil
+}
+
+// findEndpoint 엔드포인트 목록에서 주소로 인덱스 검색
+func findEndpoint(endpoints []Endpoint, address string) int {
+	for i, ep := range endpoints {
+		if ep.Address == address {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,573 @@
+package gslb
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const route53XMLNS = "https://route53.amazonaws.com/doc/2013-04-01/"
+
+// Route53Provider Route 53 스타일 레코드셋 API 기반 GSLB 공급자
+//
+// 매핑 규칙:
+//   - 라우팅 정책(SetIdentifier)이 있는 레코드 이름 하나 → GSLB
+//   - SetIdentifier 하나 → 풀 (Failover PRIMARY/SECONDARY는 order 1/2)
+//   - 레코드 값 하나 → 엔드포인트 (가중치는 레코드셋 가중치)
+//
+// 엔드포인트 비활성화는 가중치 0으로 표현하며, 재활성화 시 이전 가중치를 복원
+type Route53Provider struct {
+	baseURL      string
+	hostedZoneID string
+	accessKey    string
+	secretKey    string
+	sessionToken string
+	region       string
+	client       *http.Client
+	retry        retryPolicy
+
+	mu            sync.Mutex
+	parkedWeights map[string]int64 // 비활성화 직전 가중치 [poolID]
+}
+
+// r53ResourceRecordSet Route 53 레코드셋
+type r53ResourceRecordSet struct {
+	Name          string          `xml:"Name"`
+	Type          string          `xml:"Type"`
+	SetIdentifier string          `xml:"SetIdentifier,omitempty"`
+	Weight        *int64          `xml:"Weight,omitempty"`
+	Region        string          `xml:"Region,omitempty"`
+	GeoLocation   *r53GeoLocation `xml:"GeoLocation,omitempty"`
+	Failover      string          `xml:"Failover,omitempty"`
+	TTL           int             `xml:"TTL,omitempty"`
+	Records       []r53Record     `xml:"ResourceRecords>ResourceRecord"`
+	AliasTarget   *r53AliasTarget `xml:"AliasTarget,omitempty"`
+	HealthCheckID string          `xml:"HealthCheckId,omitempty"`
+}
+
+// r53Record 레코드 값
+type r53Record struct {
+	Value string `xml:"Value"`
+}
+
+// r53GeoLocation 지역 라우팅 조건
+type r53GeoLocation struct {
+	ContinentCode   string `xml:"ContinentCode,omitempty"`
+	CountryCode     string `xml:"CountryCode,omitempty"`
+	SubdivisionCode string `xml:"SubdivisionCode,omitempty"`
+}
+
+// r53AliasTarget 별칭 레코드 대상
+type r53AliasTarget struct {
+	HostedZoneID         string `xml:"HostedZoneId"`
+	DNSName              string `xml:"DNSName"`
+	EvaluateTargetHealth bool   `xml:"EvaluateTargetHealth"`
+}
+
+// r53ListResponse ListResourceRecordSets 응답
+type r53ListResponse struct {
+	RecordSets           []r53ResourceRecordSet `xml:"ResourceRecordSets>ResourceRecordSet"`
+	IsTruncated          bool                   `xml:"IsTruncated"`
+	NextRecordName       string                 `xml:"NextRecordName"`
+	NextRecordType       string                 `xml:"NextRecordType"`
+	NextRecordIdentifier string                 `xml:"NextRecordIdentifier"`
+}
+
+// r53HealthCheckStatusResponse GetHealthCheckStatus 응답
+type r53HealthCheckStatusResponse struct {
+	Observations []struct {
+		Region string `xml:"Region"`
+		Status string `xml:"StatusReport>Status"`
+	} `xml:"HealthCheckObservations>HealthCheckObservation"`
+}
+
+// r53ChangeRequest ChangeResourceRecordSets 요청
+type r53ChangeRequest struct {
+	XMLName xml.Name    `xml:"ChangeResourceRecordSetsRequest"`
+	XMLNS   string      `xml:"xmlns,attr"`
+	Comment string      `xml:"ChangeBatch>Comment,omitempty"`
+	Changes []r53Change `xml:"ChangeBatch>Changes>Change"`
+}
+
+// r53Change 레코드셋 변경 항목
+type r53Change struct {
+	Action    string               `xml:"Action"`
+	RecordSet r53ResourceRecordSet `xml:"ResourceRecordSet"`
+}
+
+// NewRoute53Provider 새 Route 53 공급자 생성
+func NewRoute53Provider() *Route53Provider {
+	baseURL := os.Getenv("ROUTE53_API_URL")
+	if baseURL == "" {
+		baseURL = "https://route53.amazonaws.com"
+	}
+
+	hostedZoneID := os.Getenv("ROUTE53_HOSTED_ZONE_ID")
+	if hostedZoneID == "" {
+		log.Printf("Warning: ROUTE53_HOSTED_ZONE_ID not set")
+	}
+
+	return &Route53Provider{
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		hostedZoneID:  strings.TrimPrefix(hostedZoneID, "/hostedzone/"),
+		accessKey:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretKey:     os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken:  os.Getenv("AWS_SESSION_TOKEN"),
+		region:        "us-east-1", // Route 53은 글로벌 서비스로 us-east-1 서명 사용
+		client:        &http.Client{},
+		retry:         retryPolicyFromEnv(),
+		parkedWeights: make(map[string]int64),
+	}
+}
+
+// Name 공급자 이름
+func (r *Route53Provider) Name() string {
+	return "route53"
+}
+
+// ListGSLBs 라우팅 정책 레코드셋을 이름별로 묶어 GSLB 목록으로 반환
+func (r *Route53Provider) ListGSLBs(ctx context.Context) ([]GSLB, error) {
+	recordSets, err := r.listRecordSets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*GSLB)
+	names := make([]string, 0)
+	for _, rs := range recordSets {
+		if rs.SetIdentifier == "" {
+			continue
+		}
+
+		g, exists := byName[rs.Name]
+		if !exists {
+			domain := strings.TrimSuffix(rs.Name, ".")
+			g = &GSLB{
+				ID:          r.hostedZoneID + "/" + domain,
+				Name:        domain,
+				Domain:      domain,
+				TTL:         rs.TTL,
+				RoutingRule: r53RoutingRule(rs),
+				Pools:       []ConnectedPool{},
+				Provider:    r.Name(),
+			}
+			byName[rs.Name] = g
+			names = append(names, rs.Name)
+		}
+
+		g.Pools = append(g.Pools, ConnectedPool{
+			Order:         r53Order(rs),
+			RegionContent: r53Region(rs),
+			Pool:          r53Pool(rs),
+		})
+	}
+
+	gslbs := make([]GSLB, 0, len(names))
+	for _, name := range names {
+		g := byName[name]
+		sort.SliceStable(g.Pools, func(i, j int) bool { return g.Pools[i].Order < g.Pools[j].Order })
+		gslbs = append(gslbs, *g)
+	}
+
+	log.Printf("[GSLB] Successfully fetched %d Route 53 GSLBs", len(gslbs))
+	return gslbs, nil
+}
+
+// ListPools 라우팅 정책 레코드셋을 풀 목록으로 반환
+func (r *Route53Provider) ListPools(ctx context.Context) ([]Pool, error) {
+	recordSets, err := r.listRecordSets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]Pool, 0)
+	for _, rs := range recordSets {
+		if rs.SetIdentifier != "" {
+			pools = append(pools, r53Pool(rs))
+		}
+	}
+	return pools, nil
+}
+
+// ListEndpoints 특정 풀의 엔드포인트 목록 조회
+func (r *Route53Provider) ListEndpoints(ctx context.Context, poolID string) ([]Endpoint, error) {
+	rs, err := r.findRecordSet(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	return r53Pool(*rs).Endpoints, nil
+}
+
+// GetHealth 레코드셋에 연결된 헬스체크 상태 조회
+// 과반수 이상의 관측 지역이 Success이면 healthy로 판단
+func (r *Route53Provider) GetHealth(ctx context.Context) ([]EndpointHealth, error) {
+	recordSets, err := r.listRecordSets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	health := make([]EndpointHealth, 0)
+	for _, rs := range recordSets {
+		if rs.SetIdentifier == "" {
+			continue
+		}
+		pool := r53Pool(rs)
+
+		status, detail := HealthUnknown, ""
+		if rs.HealthCheckID != "" {
+			status, detail, err = r.healthCheckStatus(ctx, rs.HealthCheckID)
+			if err != nil {
+				log.Printf("[GSLB] Failed to get Route 53 health check %s: %v", rs.HealthCheckID, err)
+				status, detail = HealthUnknown, err.Error()
+			}
+		}
+
+		for _, ep := range pool.Endpoints {
+			h := EndpointHealth{PoolID: pool.ID, Address: ep.Address, Status: status, Detail: detail}
+			if ep.Disabled {
+				h.Status = HealthDisabled
+			}
+			health = append(health, h)
+		}
+	}
+	return health, nil
+}
+
+// UpdateEndpoint 레코드셋 가중치 변경
+// Route 53은 레코드셋 단위로 가중치를 가지므로 같은 레코드셋의 모든 값에 적용됨
+func (r *Route53Provider) UpdateEndpoint(ctx context.Context, poolID string, endpoint Endpoint) error {
+	rs, err := r.findRecordSet(ctx, poolID)
+	if err != nil {
+		return err
+	}
+	if findEndpoint(r53Pool(*rs).Endpoints, endpoint.Address) < 0 {
+		return notFoundf("endpoint %s not found in pool %s", endpoint.Address, poolID)
+	}
+	if rs.Weight == nil {
+		return fmt.Errorf("pool %s is not a weighted record set; endpoint state cannot be changed", poolID)
+	}
+
+	r.mu.Lock()
+	weight := int64(endpoint.Weight)
+	if endpoint.Disabled {
+		if *rs.Weight > 0 {
+			r.parkedWeights[poolID] = *rs.Weight
+		}
+		weight = 0
+	} else if weight <= 0 {
+		// 가중치 지정 없이 재활성화하면 비활성화 직전 값 복원
+		weight = r.parkedWeights[poolID]
+		if weight <= 0 {
+			weight = 1
+		}
+		delete(r.parkedWeights, poolID)
+	}
+	r.mu.Unlock()
+
+	updated := *rs
+	updated.Weight = &weight
+
+	change := r53ChangeRequest{
+		XMLNS:   route53XMLNS,
+		Comment: "pf-dashboard endpoint update",
+		Changes: []r53Change{{Action: "UPSERT", RecordSet: updated}},
+	}
+	body, err := xml.Marshal(change)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	path := fmt.Sprintf("/2013-04-01/hostedzone/%s/rrset/", r.hostedZoneID)
+	if err := r.do(ctx, http.MethodPost, path, nil, body, nil); err != nil {
+		return err
+	}
+
+	log.Printf("[GSLB] Updated Route 53 record set %s (weight: %d)", poolID, weight)
+	return nil
+}
+
+// listRecordSets 호스팅 영역의 모든 레코드셋 조회 (페이지네이션 처리)
+func (r *Route53Provider) listRecordSets(ctx context.Context) ([]r53ResourceRecordSet, error) {
+	if r.hostedZoneID == "" {
+		return nil, notConfigured("ROUTE53_HOSTED_ZONE_ID is not configured")
+	}
+
+	path := fmt.Sprintf("/2013-04-01/hostedzone/%s/rrset", r.hostedZoneID)
+	query := url.Values{}
+	recordSets := make([]r53ResourceRecordSet, 0)
+
+	for {
+		var resp r53ListResponse
+		if err := r.do(ctx, http.MethodGet, path, query, nil, &resp); err != nil {
+			return nil, err
+		}
+		recordSets = append(recordSets, resp.RecordSets...)
+
+		if !resp.IsTruncated {
+			break
+		}
+		query = url.Values{}
+		query.Set("name", resp.NextRecordName)
+		query.Set("type", resp.NextRecordType)
+		if resp.NextRecordIdentifier != "" {
+			query.Set("identifier", resp.NextRecordIdentifier)
+		}
+	}
+
+	return recordSets, nil
+}
+
+// findRecordSet 풀 ID(레코드 이름/SetIdentifier)로 레코드셋 검색
+func (r *Route53Provider) findRecordSet(ctx context.Context, poolID string) (*r53ResourceRecordSet, error) {
+	recordSets, err := r.listRecordSets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rs := range recordSets {
+		if rs.SetIdentifier != "" && r53PoolID(rs) == poolID {
+			return &rs, nil
+		}
+	}
+	return nil, notFoundf("pool '%s' not found", poolID)
+}
+
+// healthCheckStatus 헬스체크 관측 결과 요약
+func (r *Route53Provider) healthCheckStatus(ctx context.Context, healthCheckID string) (string, string, error) {
+	var resp r53HealthCheckStatusResponse
+	path := fmt.Sprintf("/2013-04-01/healthcheck/%s/status", healthCheckID)
+	if err := r.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return "", "", err
+	}
+
+	if len(resp.Observations) == 0 {
+		return HealthUnknown, "no observations", nil
+	}
+
+	success := 0
+	for _, obs := range resp.Observations {
+		if strings.HasPrefix(obs.Status, "Success") {
+			success++
+		}
+	}
+
+	detail := fmt.Sprintf("%d/%d checkers report success", success, len(resp.Observations))
+	if success*2 > len(resp.Observations) {
+		return HealthHealthy, detail, nil
+	}
+	return HealthUnhealthy, detail, nil
+}
+
+// do 서명된 Route 53 API 요청 실행 (5xx/429 응답과 전송 실패는 재시도)
+func (r *Route53Provider) do(ctx context.Context, method, path string, query url.Values, body []byte, out interface{}) error {
+	if r.accessKey == "" || r.secretKey == "" {
+		return notConfigured("AWS_ACCESS_KEY_ID/AWS_SECRET_ACCESS_KEY are not configured")
+	}
+
+	return r.retry.run(ctx, func(ctx context.Context) error {
+		return r.doOnce(ctx, method, path, query, body, out)
+	})
+}
+
+// doOnce 서명된 Route 53 API 요청 1회 실행 (재시도마다 새로 서명)
+func (r *Route53Provider) doOnce(ctx context.Context, method, path string, query url.Values, body []byte, out interface{}) error {
+	op := method + " " + path
+	u := r.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return &Error{Op: op, Message: "failed to create request", Err: err}
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/xml")
+	}
+	r.sign(req, body, time.Now().UTC())
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return transportError(op, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return transportError(op, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return statusError(op, resp.StatusCode, string(respBody))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := xml.Unmarshal(respBody, out); err != nil {
+		return &Error{Op: op, Message: "failed to parse response", Err: err}
+	}
+	return nil
+}
+
+// sign AWS Signature Version 4 서명 추가
+func (r *Route53Provider) sign(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	dateStamp := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if r.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", r.sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalPath := req.URL.EscapedPath()
+	if canonicalPath == "" {
+		canonicalPath = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalPath,
+		strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20"),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := dateStamp + "/" + r.region + "/route53/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+r.secretKey), dateStamp)
+	key = hmacSHA256(key, r.region)
+	key = hmacSHA256(key, "route53")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		r.accessKey, scope, signedHeaders, signature))
+}
+
+// r53PoolID 레코드셋의 풀 ID (레코드 이름/SetIdentifier)
+func r53PoolID(rs r53ResourceRecordSet) string {
+	return strings.TrimSuffix(rs.Name, ".") + "/" + rs.SetIdentifier
+}
+
+// r53Pool 레코드셋을 중립 풀 형식으로 변환
+func r53Pool(rs r53ResourceRecordSet) Pool {
+	weight := float64(1)
+	disabled := false
+	if rs.Weight != nil {
+		weight = float64(*rs.Weight)
+		disabled = *rs.Weight == 0
+	}
+
+	pool := Pool{
+		ID:            r53PoolID(rs),
+		Name:          rs.SetIdentifier,
+		HealthCheckID: rs.HealthCheckID,
+		Endpoints:     []Endpoint{},
+	}
+
+	for _, rec := range rs.Records {
+		pool.Endpoints = append(pool.Endpoints, Endpoint{Address: rec.Value, Weight: weight, Disabled: disabled})
+	}
+	if rs.AliasTarget != nil {
+		pool.Endpoints = append(pool.Endpoints, Endpoint{
+			Address:  strings.TrimSuffix(rs.AliasTarget.DNSName, "."),
+			Weight:   weight,
+			Disabled: disabled,
+		})
+	}
+
+	return pool
+}
+
+// r53RoutingRule 레코드셋의 라우팅 정책을 중립 라우팅 규칙으로 변환
+func r53RoutingRule(rs r53ResourceRecordSet) string {
+	switch {
+	case rs.Failover != "":
+		return RoutingFailover
+	case rs.GeoLocation != nil:
+		return RoutingGeolocation
+	case rs.Weight != nil:
+		return RoutingRandom
+	default:
+		// 지연 시간 기반(Region) 등 중립 규칙이 없는 정책
+		return "LATENCY"
+	}
+}
+
+// r53Order Failover 역할을 우선순위로 변환
+func r53Order(rs r53ResourceRecordSet) int {
+	switch rs.Failover {
+	case "PRIMARY":
+		return 1
+	case "SECONDARY":
+		return 2
+	default:
+		return 1
+	}
+}
+
+// r53Region 지역 조건을 문자열로 변환 (국가 > 대륙 > 리전 순)
+func r53Region(rs r53ResourceRecordSet) string {
+	if rs.GeoLocation != nil {
+		switch {
+		case rs.GeoLocation.CountryCode == "*":
+			return "DEFAULT"
+		case rs.GeoLocation.CountryCode != "":
+			return rs.GeoLocation.CountryCode
+		case rs.GeoLocation.ContinentCode != "":
+			return rs.GeoLocation.ContinentCode
+		}
+	}
+	return rs.Region
+}
+
+// sha256Hex SHA-256 해시 16진 문자열
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// hmacSHA256 HMAC-SHA256 계산
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,285 @@
+package gslb
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SimulationRequest 라우팅 시뮬레이션 요청
+type SimulationRequest struct {
+	GSLBName     string `json:"gslbName"`
+	ClientRegion string `json:"clientRegion"` // 예: "KR" 또는 "KR,ASIA" (앞쪽이 우선)
+	// 장애로 가정할 대상: 엔드포인트 주소, 풀 ID 또는 풀 이름
+	Unhealthy []string `json:"unhealthy"`
+	// 공급자가 보고하는 현재 unhealthy 엔드포인트도 장애로 가정
+	UseCurrentHealth bool `json:"useCurrentHealth"`
+}
+
+// SimulatedEndpoint 응답될 엔드포인트와 확률
+type SimulatedEndpoint struct {
+	Address     string  `json:"address"`
+	Weight      float64 `json:"weight"`
+	Probability float64 `json:"probability"` // 전체 질의 중 이 엔드포인트가 응답될 확률 (0~1)
+}
+
+// SimulatedPool 응답될 풀과 확률
+type SimulatedPool struct {
+	PoolID        string              `json:"poolId"`
+	PoolName      string              `json:"poolName"`
+	Order         int                 `json:"order"`
+	RegionContent string              `json:"regionContent,omitempty"`
+	Probability   float64             `json:"probability"`
+	Endpoints     []SimulatedEndpoint `json:"endpoints"`
+}
+
+// SkippedPool 응답에서 제외된 풀과 사유
+type SkippedPool struct {
+	PoolID   string `json:"poolId"`
+	PoolName string `json:"poolName"`
+	Reason   string `json:"reason"`
+}
+
+// SimulationResult 라우팅 시뮬레이션 결과
+type SimulationResult struct {
+	GSLBName     string          `json:"gslbName"`
+	Domain       string          `json:"domain"`
+	RoutingRule  string          `json:"routingRule"`
+	ClientRegion string          `json:"clientRegion,omitempty"`
+	Unhealthy    []string        `json:"unhealthy"`
+	Answered     []SimulatedPool `json:"answered"`
+	Skipped      []SkippedPool   `json:"skipped"`
+	NoAnswer     bool            `json:"noAnswer"` // 응답 가능한 엔드포인트가 하나도 없음
+	Explanation  []string        `json:"explanation"`
+}
+
+// Simulate GSLB 라우팅 규칙을 적용해 가상의 클라이언트 질의에 어떤 풀/엔드포인트가 응답될지 계산
+//
+//   - FAILOVER: order가 가장 낮은 풀 중 사용 가능한 엔드포인트가 있는 첫 풀
+//   - RANDOM: 사용 가능한 모든 엔드포인트에 가중치 비례로 분산
+//   - GEOLOCATION: 클라이언트 지역과 일치하는 풀(없으면 DEFAULT/빈 지역 풀) 중 order 순 첫 풀
+//
+// 선택된 풀 안에서는 엔드포인트 가중치 비례로 분산 (가중치가 모두 0이면 균등)
+func Simulate(g GSLB, clientRegion string, unhealthy []string) SimulationResult {
+	down := make(map[string]bool, len(unhealthy))
+	for _, item := range unhealthy {
+		down[item] = true
+	}
+
+	result := SimulationResult{
+		GSLBName:     g.Name,
+		Domain:       g.Domain,
+		RoutingRule:  g.RoutingRule,
+		ClientRegion: clientRegion,
+		Unhealthy:    append([]string{}, unhealthy...),
+		Answered:     []SimulatedPool{},
+		Skipped:      []SkippedPool{},
+		Explanation:  []string{},
+	}
+
+	if g.Disabled {
+		result.NoAnswer = true
+		result.Explanation = append(result.Explanation, "GSLB is disabled; no records are answered")
+		return result
+	}
+
+	pools := append([]ConnectedPool{}, g.Pools...)
+	sort.SliceStable(pools, func(i, j int) bool { return pools[i].Order < pools[j].Order })
+
+	// 풀별 사용 가능한 엔드포인트 계산
+	usable := make(map[string][]Endpoint)
+	candidates := make([]ConnectedPool, 0, len(pools))
+	for _, cp := range pools {
+		if reason := poolUnusableReason(cp, down); reason != "" {
+			result.Skipped = append(result.Skipped, SkippedPool{PoolID: cp.Pool.ID, PoolName: cp.Pool.Name, Reason: reason})
+			continue
+		}
+		usable[cp.Pool.ID] = usableEndpoints(cp.Pool, down)
+		candidates = append(candidates, cp)
+	}
+
+	switch strings.ToUpper(g.RoutingRule) {
+	case RoutingFailover:
+		if len(candidates) > 0 {
+			chosen := candidates[0]
+			result.Answered = append(result.Answered, simulatedPool(chosen, usable[chosen.Pool.ID], 1))
+			result.Explanation = append(result.Explanation,
+				fmt.Sprintf("FAILOVER: pool %s (order %d) is the highest-priority pool with healthy endpoints", chosen.Pool.Name, chosen.Order))
+			for _, cp := range candidates[1:] {
+				result.Skipped = append(result.Skipped, SkippedPool{PoolID: cp.Pool.ID, PoolName: cp.Pool.Name,
+					Reason: fmt.Sprintf("standby (order %d)", cp.Order)})
+			}
+		}
+
+	case RoutingGeolocation:
+		matched, matchedBy := geoCandidates(candidates, clientRegion)
+		for _, cp := range candidates {
+			if !containsPool(matched, cp.Pool.ID) {
+				result.Skipped = append(result.Skipped, SkippedPool{PoolID: cp.Pool.ID, PoolName: cp.Pool.Name,
+					Reason: fmt.Sprintf("region %q does not serve client region %q", cp.RegionContent, clientRegion)})
+			}
+		}
+		if len(matched) > 0 {
+			chosen := matched[0]
+			result.Answered = append(result.Answered, simulatedPool(chosen, usable[chosen.Pool.ID], 1))
+			result.Explanation = append(result.Explanation,
+				fmt.Sprintf("GEOLOCATION: client region %q matched %s; pool %s (order %d) answers", clientRegion, matchedBy, chosen.Pool.Name, chosen.Order))
+			for _, cp := range matched[1:] {
+				result.Skipped = append(result.Skipped, SkippedPool{PoolID: cp.Pool.ID, PoolName: cp.Pool.Name,
+					Reason: fmt.Sprintf("standby for region (order %d)", cp.Order)})
+			}
+		}
+
+	default:
+		if strings.ToUpper(g.RoutingRule) != RoutingRandom {
+			result.Explanation = append(result.Explanation,
+				fmt.Sprintf("routing rule %q is not modelled; simulated as weighted RANDOM", g.RoutingRule))
+		}
+
+		total := 0.0
+		for _, cp := range candidates {
+			total += effectiveWeightSum(usable[cp.Pool.ID])
+		}
+		for _, cp := range candidates {
+			share := 1.0 / float64(len(candidates))
+			if total > 0 {
+				share = effectiveWeightSum(usable[cp.Pool.ID]) / total
+			}
+			result.Answered = append(result.Answered, simulatedPool(cp, usable[cp.Pool.ID], share))
+		}
+		if len(candidates) > 0 {
+			result.Explanation = append(result.Explanation,
+				fmt.Sprintf("RANDOM: traffic is spread across %d pools in proportion to endpoint weights", len(candidates)))
+		}
+	}
+
+	if len(result.Answered) == 0 {
+		result.NoAnswer = true
+		result.Explanation = append(result.Explanation, "no pool has healthy endpoints; clients receive no answer")
+	}
+	return result
+}
+
+// poolUnusableReason 풀을 응답에 사용할 수 없는 사유 (사용 가능하면 빈 문자열)
+func poolUnusableReason(cp ConnectedPool, down map[string]bool) string {
+	switch {
+	case cp.Pool.Disabled:
+		return "pool disabled"
+	case down[cp.Pool.ID] || down[cp.Pool.Name]:
+		return "pool assumed unhealthy"
+	}
+
+	if len(usableEndpoints(cp.Pool, down)) == 0 {
+		return "no healthy enabled endpoints"
+	}
+	return ""
+}
+
+// usableEndpoints 활성이고 장애로 가정되지 않은 엔드포인트
+func usableEndpoints(pool Pool, down map[string]bool) []Endpoint {
+	endpoints := make([]Endpoint, 0, len(pool.Endpoints))
+	for _, ep := range pool.Endpoints {
+		if !ep.Disabled && !down[ep.Address] {
+			endpoints = append(endpoints, ep)
+		}
+	}
+	return endpoints
+}
+
+// effectiveWeightSum 가중치 합 (모두 0이면 엔드포인트 수로 간주)
+func effectiveWeightSum(endpoints []Endpoint) float64 {
+	total := 0.0
+	for _, ep := range endpoints {
+		total += ep.Weight
+	}
+	if total <= 0 {
+		return float64(len(endpoints))
+	}
+	return total
+}
+
+// simulatedPool 풀 확률을 엔드포인트 가중치로 나눠 결과 생성
+func simulatedPool(cp ConnectedPool, endpoints []Endpoint, poolProbability float64) SimulatedPool {
+	sp := SimulatedPool{
+		PoolID:        cp.Pool.ID,
+		PoolName:      cp.Pool.Name,
+		Order:         cp.Order,
+		RegionContent: cp.RegionContent,
+		Probability:   poolProbability,
+		Endpoints:     make([]SimulatedEndpoint, 0, len(endpoints)),
+	}
+
+	total := 0.0
+	for _, ep := range endpoints {
+		total += ep.Weight
+	}
+	for _, ep := range endpoints {
+		share := 1.0 / float64(len(endpoints))
+		if total > 0 {
+			share = ep.Weight / total
+		}
+		sp.Endpoints = append(sp.Endpoints, SimulatedEndpoint{
+			Address:     ep.Address,
+			Weight:      ep.Weight,
+			Probability: poolProbability * share,
+		})
+	}
+	return sp
+}
+
+// geoCandidates 클라이언트 지역에 응답할 풀 목록 (order 순)
+// 클라이언트 지역 토큰을 앞에서부터 확인하고, 일치하는 풀이 없으면 DEFAULT 또는 지역 미지정 풀 사용
+func geoCandidates(candidates []ConnectedPool, clientRegion string) ([]ConnectedPool, string) {
+	for _, token := range regionTokens(clientRegion) {
+		matched := make([]ConnectedPool, 0)
+		for _, cp := range candidates {
+			for _, region := range regionTokens(cp.RegionContent) {
+				if region == token {
+					matched = append(matched, cp)
+					break
+				}
+			}
+		}
+		if len(matched) > 0 {
+			return matched, fmt.Sprintf("region %q", token)
+		}
+	}
+
+	fallback := make([]ConnectedPool, 0)
+	for _, cp := range candidates {
+		regions := regionTokens(cp.RegionContent)
+		if len(regions) == 0 || containsString(regions, "DEFAULT") {
+			fallback = append(fallback, cp)
+		}
+	}
+	return fallback, "the default region"
+}
+
+// regionTokens "KR, JP" 같은 지역 문자열을 대문자 토큰으로 분리
+func regionTokens(value string) []string {
+	tokens := make([]string, 0)
+	for _, token := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' || r == ';' }) {
+		tokens = append(tokens, strings.ToUpper(token))
+	}
+	return tokens
+}
+
+// containsPool 풀 목록에 ID가 있는지
+func containsPool(pools []ConnectedPool, poolID string) bool {
+	for _, cp := range pools {
+		if cp.Pool.ID == poolID {
+			return true
+		}
+	}
+	return false
+}
+
+// containsString 문자열 목록에 값이 있는지
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/alertmanager"
+)
+
+// maxWebhookBodyBytes Alertmanager webhook 본문 최대 크기 (그룹 알림이 많아도 충분한 크기)
+const maxWebhookBodyBytes = 1 << 20
+
+// AlertmanagerWebhookResponse webhook 처리 결과
+type AlertmanagerWebhookResponse struct {
+	Received int `json:"received"`
+	Recorded int `json:"recorded"`
+}
+
+// AlertmanagerHandler Alertmanager 연동 API 핸들러
+type AlertmanagerHandler struct {
+	pusher   *alertmanager.Pusher
+	receiver *alertmanager.Receiver
+}
+
+// NewAlertmanagerHandler 새 Alertmanager 핸들러 생성
+func NewAlertmanagerHandler(pusher *alertmanager.Pusher, receiver *alertmanager.Receiver) *AlertmanagerHandler {
+	return &AlertmanagerHandler{
+		pusher:   pusher,
+		receiver: receiver,
+	}
+}
+
+// HandleWebhook Alertmanager webhook 알림을 이벤트로 기록
+// POST /api/alertmanager/webhook
+func (h *AlertmanagerHandler) HandleWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.receiver.Enabled() {
+		http.Error(w, alertmanager.ErrWebhookDisabled.Error(), http.StatusForbidden)
+		return
+	}
+	if !h.receiver.Authorize(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var msg alertmanager.WebhookMessage
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWebhookBodyBytes)).Decode(&msg); err != nil {
+		http.Error(w, "invalid webhook body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := AlertmanagerWebhookResponse{
+		Received: len(msg.Alerts),
+		Recorded: h.receiver.Receive(msg),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Printf("[AlertmanagerHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// HandleStatus Alertmanager 전송 현황과 firing 알림 조회
+// GET /api/alertmanager/status
+func (h *AlertmanagerHandler) HandleStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(h.pusher.Status()); err != nil {
+		log.Printf("[AlertmanagerHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,383 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/monitor"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	defaultResourceLimit = 100
+	maxResourceLimit     = 1000
+)
+
+// ClusterSummary 클러스터 요약 (노드/Pod 목록은 하위 리소스로 조회)
+type ClusterSummary struct {
+	ID          string                      `json:"id"`
+	Name        string                      `json:"name"`
+	Status      string                      `json:"status"`
+	Reason      string                      `json:"reason,omitempty"`
+	Region      string                      `json:"region"`
+	Pods        int                         `json:"pods"`
+	Sessions    int                         `json:"sessions"`
+	Nodes       int                         `json:"nodes"`
+	ReadyNodes  int                         `json:"readyNodes"`
+	Utilization *monitor.ClusterUtilization `json:"utilization,omitempty"`
+	Probes      *monitor.ProbeHealth        `json:"probes,omitempty"`
+	CheckedAt   time.Time                   `json:"checkedAt"`
+}
+
+// ResourceList 클러스터/노드/Pod 목록 응답
+type ResourceList struct {
+	CheckedAt  time.Time     `json:"checkedAt"` // 스냅샷 확인 시각
+	Total      int           `json:"total"`     // 필터 적용 후 전체 개수
+	Items      []interface{} `json:"items"`
+	NextCursor int           `json:"nextCursor,omitempty"` // 다음 페이지 cursor (없으면 생략)
+}
+
+// resourceQuery 목록 조회 조건
+type resourceQuery struct {
+	statuses   []string
+	namespaces []string
+	node       string
+	selector   labels.Selector
+	fields     []string
+	cursor     int
+	limit      int
+}
+
+// ClustersHandler 클러스터 리소스 API 핸들러
+// 노드 상세를 제외한 조회는 마지막 클러스터 확인 결과(스냅샷)를 사용
+type ClustersHandler struct {
+	monitor *monitor.MultiClusterMonitor
+}
+
+// NewClustersHandler 새 클러스터 리소스 핸들러 생성
+func NewClustersHandler(monitor *monitor.MultiClusterMonitor) *ClustersHandler {
+	return &ClustersHandler{
+		monitor: monitor,
+	}
+}
+
+// HandleClusters 클러스터 목록 조회
+// GET /api/clusters?status=ready,degraded&fields=id,status&limit=10&cursor=0
+func (h *ClustersHandler) HandleClusters(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	q, err := parseResourceQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	clusters, checkedAt, ok := h.snapshot(w)
+	if !ok {
+		return
+	}
+
+	items := make([]interface{}, 0, len(clusters))
+	for _, cluster := range clusters {
+		if matchesAny(q.statuses, cluster.Status) {
+			items = append(items, clusterSummary(cluster, checkedAt))
+		}
+	}
+	writeResourceList(w, checkedAt, items, q)
+}
+
+// HandleClusterResource /api/clusters/ 하위 경로 처리
+// GET /api/clusters/{id}
+// GET /api/clusters/{id}/nodes?status=NotReady&labelSelector=node-role.kubernetes.io/control-plane
+// GET /api/clusters/{id}/nodes/{name}
+// GET /api/clusters/{id}/pods?namespace=tf-monitor&status=Running,CrashLoopBackOff&labelSelector=app=web&node=w1
+func (h *ClustersHandler) HandleClusterResource(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/clusters/"), "/"), "/")
+	if parts[0] == "" {
+		h.HandleClusters(w, r)
+		return
+	}
+	switch {
+	case len(parts) == 1:
+		h.handleCluster(w, r, parts[0])
+	case len(parts) == 2 && parts[1] == "nodes":
+		h.handleNodes(w, r, parts[0])
+	case len(parts) == 2 && parts[1] == "pods":
+		h.handlePods(w, r, parts[0])
+	case len(parts) == 3 && parts[1] == "nodes" && parts[2] != "":
+		h.handleNode(w, r, parts[0], parts[2])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// handleCluster 클러스터 하나의 요약
+func (h *ClustersHandler) handleCluster(w http.ResponseWriter, r *http.Request, clusterID string) {
+	q, err := parseResourceQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	cluster, checkedAt, ok := h.cluster(w, clusterID)
+	if !ok {
+		return
+	}
+
+	summary, err := selectFields(clusterSummary(cluster, checkedAt), q.fields)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeClusterJSON(w, summary)
+}
+
+// handleNodes 클러스터 노드 목록 (이름순)
+func (h *ClustersHandler) handleNodes(w http.ResponseWriter, r *http.Request, clusterID string) {
+	q, err := parseResourceQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	cluster, checkedAt, ok := h.cluster(w, clusterID)
+	if !ok {
+		return
+	}
+
+	nodes := append([]monitor.NodeInfo(nil), cluster.Nodes...)
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+	items := make([]interface{}, 0, len(nodes))
+	for _, node := range nodes {
+		status := node.Status
+		if node.Unschedulable && matchesAny(q.statuses, "SchedulingDisabled") {
+			status = "SchedulingDisabled"
+		}
+		if matchesAny(q.statuses, status) && q.selector.Matches(labels.Set(node.Labels)) {
+			items = append(items, node)
+		}
+	}
+	writeResourceList(w, checkedAt, items, q)
+}
+
+// handlePods 클러스터 Pod 목록 (네임스페이스, 이름순)
+// status는 Pod phase 또는 대표 원인(reason)과 비교
+func (h *ClustersHandler) handlePods(w http.ResponseWriter, r *http.Request, clusterID string) {
+	q, err := parseResourceQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	cluster, checkedAt, ok := h.cluster(w, clusterID)
+	if !ok {
+		return
+	}
+
+	pods := append([]monitor.PodInfo(nil), cluster.PodList...)
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+		return pods[i].Name < pods[j].Name
+	})
+
+	items := make([]interface{}, 0, len(pods))
+	for _, pod := range pods {
+		if !matchesAny(q.namespaces, pod.Namespace) ||
+			(q.node != "" && pod.Node != q.node) ||
+			!(matchesAny(q.statuses, pod.Status) || (pod.Reason != "" && matchesAny(q.statuses, pod.Reason))) ||
+			!q.selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		items = append(items, pod)
+	}
+	writeResourceList(w, checkedAt, items, q)
+}
+
+// handleNode 노드 상세 조회 (조건, taint, 토폴로지, 리소스, 노드의 Pod)
+func (h *ClustersHandler) handleNode(w http.ResponseWriter, r *http.Request, clusterID, name string) {
+	detail, err := h.monitor.NodeDetail(r.Context(), clusterID, name)
+	if err != nil {
+		writeClusterError(w, err)
+		return
+	}
+	writeClusterJSON(w, detail)
+}
+
+// snapshot 마지막 클러스터 확인 결과 (아직 없으면 503 응답)
+func (h *ClustersHandler) snapshot(w http.ResponseWriter) ([]monitor.ClusterInfo, time.Time, bool) {
+	clusters, checkedAt := h.monitor.Snapshot()
+	if clusters == nil {
+		http.Error(w, "cluster status has not been checked yet", http.StatusServiceUnavailable)
+		return nil, time.Time{}, false
+	}
+	return clusters, checkedAt, true
+}
+
+// cluster 스냅샷에서 클러스터 하나 (없으면 404 응답)
+func (h *ClustersHandler) cluster(w http.ResponseWriter, clusterID string) (monitor.ClusterInfo, time.Time, bool) {
+	clusters, checkedAt, ok := h.snapshot(w)
+	if !ok {
+		return monitor.ClusterInfo{}, time.Time{}, false
+	}
+	for _, cluster := range clusters {
+		if cluster.ID == clusterID {
+			return cluster, checkedAt, true
+		}
+	}
+	writeClusterError(w, fmt.Errorf("%w: %s", monitor.ErrClusterNotFound, clusterID))
+	return monitor.ClusterInfo{}, time.Time{}, false
+}
+
+// clusterSummary 클러스터 요약 생성
+func clusterSummary(cluster monitor.ClusterInfo, checkedAt time.Time) ClusterSummary {
+	summary := ClusterSummary{
+		ID:          cluster.ID,
+		Name:        cluster.Name,
+		Status:      cluster.Status,
+		Reason:      cluster.Reason,
+		Region:      cluster.Region,
+		Pods:        cluster.Pods,
+		Sessions:    cluster.Sessions,
+		Nodes:       len(cluster.Nodes),
+		Utilization: cluster.Utilization,
+		Probes:      cluster.Probes,
+		CheckedAt:   checkedAt,
+	}
+	for _, node := range cluster.Nodes {
+		if node.Status == "Ready" {
+			summary.ReadyNodes++
+		}
+	}
+	return summary
+}
+
+// parseResourceQuery 쿼리 파라미터를 목록 조회 조건으로 변환
+func parseResourceQuery(values url.Values) (resourceQuery, error) {
+	q := resourceQuery{
+		statuses:   splitParam(values.Get("status")),
+		namespaces: splitParam(values.Get("namespace")),
+		node:       values.Get("node"),
+		fields:     splitParam(values.Get("fields")),
+		limit:      defaultResourceLimit,
+	}
+
+	selector, err := labels.Parse(values.Get("labelSelector"))
+	if err != nil {
+		return q, fmt.Errorf("invalid labelSelector: %w", err)
+	}
+	q.selector = selector
+
+	if cursor := values.Get("cursor"); cursor != "" {
+		n, err := strconv.Atoi(cursor)
+		if err != nil || n < 0 {
+			return q, fmt.Errorf("invalid cursor %q", cursor)
+		}
+		q.cursor = n
+	}
+
+	if limit := values.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			return q, fmt.Errorf("invalid limit %q", limit)
+		}
+		if n > maxResourceLimit {
+			n = maxResourceLimit
+		}
+		q.limit = n
+	}
+	return q, nil
+}
+
+// matchesAny 값이 목록 중 하나와 같은지 (대소문자 무시, 목록이 비어 있으면 true)
+func matchesAny(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// selectFields fields에 지정한 최상위 JSON 필드만 남김 (비어 있으면 그대로)
+func selectFields(item interface{}, fields []string) (interface{}, error) {
+	if len(fields) == 0 {
+		return item, nil
+	}
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]json.RawMessage, len(fields))
+	for _, field := range fields {
+		if value, ok := all[field]; ok {
+			selected[field] = value
+		}
+	}
+	return selected, nil
+}
+
+// writeResourceList cursor/limit으로 자르고 필드 선택 후 목록 응답 작성
+func writeResourceList(w http.ResponseWriter, checkedAt time.Time, items []interface{}, q resourceQuery) {
+	list := ResourceList{CheckedAt: checkedAt, Total: len(items), Items: []interface{}{}}
+
+	if q.cursor < len(items) {
+		end := q.cursor + q.limit
+		if end < len(items) {
+			list.NextCursor = end
+		} else {
+			end = len(items)
+		}
+		for _, item := range items[q.cursor:end] {
+			selected, err := selectFields(item, q.fields)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			list.Items = append(list.Items, selected)
+		}
+	}
+	writeClusterJSON(w, list)
+}
+
+// writeClusterJSON JSON 응답 작성
+func writeClusterJSON(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("[ClustersHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// writeClusterError 클러스터 조회 오류를 HTTP 상태 코드로 변환
+func writeClusterError(w http.ResponseWriter, err error) {
+	status := http.StatusBadGateway
+	switch {
+	case errors.Is(err, monitor.ErrClusterNotFound), errors.Is(err, monitor.ErrNodeNotFound):
+		status = http.StatusNotFound
+	}
+	http.Error(w, err.Error(), status)
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/probe"
+)
+
+// DNSProbeResponse DNS 프로브 이력 응답
+type DNSProbeResponse struct {
+	Servers []string          `json:"servers"`
+	Results []probe.DNSResult `json:"results"`
+}
+
+// DNSProbeHandler DNS 프로브 API 핸들러
+type DNSProbeHandler struct {
+	dnsProbe *probe.DNSProbe
+}
+
+// NewDNSProbeHandler 새 DNS 프로브 핸들러 생성
+func NewDNSProbeHandler(dnsProbe *probe.DNSProbe) *DNSProbeHandler {
+	return &DNSProbeHandler{
+		dnsProbe: dnsProbe,
+	}
+}
+
+// HandleHistory GSLB 도메인 DNS 해석 이력 조회 (최신순)
+// GET /api/gslb/dns?domain=<domain>&limit=<n>
+func (h *DNSProbeHandler) HandleHistory(w http.ResponseWriter, r *http.Request) {
+	domain := r.URL.Query().Get("domain")
+
+	limit := 50
+	if value := r.URL.Query().Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			http.Error(w, "limit must be a non-negative integer", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+
+	response := DNSProbeResponse{
+		Servers: h.dnsProbe.Servers(),
+		Results: h.dnsProbe.History(domain, limit),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Printf("[DNSProbeHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
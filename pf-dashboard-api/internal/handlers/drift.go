@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/reconcile"
+)
+
+// DriftHandler GSLB 선언 상태 drift API 핸들러
+type DriftHandler struct {
+	reconciler *reconcile.Reconciler
+}
+
+// NewDriftHandler 새 drift 핸들러 생성
+func NewDriftHandler(reconciler *reconcile.Reconciler) *DriftHandler {
+	return &DriftHandler{
+		reconciler: reconciler,
+	}
+}
+
+// HandleDrift 마지막 drift 보고 조회, POST면 즉시 다시 비교
+// GET  /api/gslb/drift
+// POST /api/gslb/drift
+func (h *DriftHandler) HandleDrift(w http.ResponseWriter, r *http.Request) {
+	if !h.reconciler.Enabled() {
+		http.Error(w, "GSLB_DESIRED_STATE is not configured", http.StatusNotFound)
+		return
+	}
+
+	var report *reconcile.Report
+	switch r.Method {
+	case http.MethodGet:
+		report = h.reconciler.Latest()
+		if report == nil {
+			report = h.reconciler.Reconcile(r.Context())
+		}
+	case http.MethodPost:
+		report = h.reconciler.Reconcile(r.Context())
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		log.Printf("[DriftHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+)
+
+const (
+	defaultEventLimit = 100
+	maxEventLimit     = 1000
+	maxEventBodyBytes = 64 << 10
+)
+
+// EventsHandler 이벤트 조회/입력 API 핸들러
+type EventsHandler struct {
+	eventLog *eventlog.EventLog
+	ingester *eventlog.Ingester
+}
+
+// NewEventsHandler 새 이벤트 핸들러 생성
+func NewEventsHandler(eventLog *eventlog.EventLog, ingester *eventlog.Ingester) *EventsHandler {
+	return &EventsHandler{
+		eventLog: eventLog,
+		ingester: ingester,
+	}
+}
+
+// HandleEvents GET은 이벤트 조회, POST는 외부 이벤트 입력
+func (h *EventsHandler) HandleEvents(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.handleQuery(w, r)
+	case http.MethodPost:
+		h.handleIngest(w, r)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleQuery 조건에 맞는 이벤트 조회
+// GET /api/events?type=&severity=critical&source=&cluster=member1&resource=&q=&since=24h&until=&cursor=&limit=100&sort=desc
+func (h *EventsHandler) handleQuery(w http.ResponseWriter, r *http.Request) {
+	q, err := parseEventQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	page, err := h.eventLog.Query(q)
+	if err != nil {
+		log.Printf("[EventsHandler] Failed to query events: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(page); err != nil {
+		log.Printf("[EventsHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleIngest 외부 이벤트를 검증해 토큰의 클라이언트 이름을 소스로 기록
+// POST /api/events (Authorization: Bearer <token>)
+// {"type": "info", "message": "deploy v1.4 started", "clusterId": "member1", "attributes": {"version": "v1.4"}}
+func (h *EventsHandler) handleIngest(w http.ResponseWriter, r *http.Request) {
+	if !h.ingester.Enabled() {
+		http.Error(w, eventlog.ErrIngestDisabled.Error(), http.StatusForbidden)
+		return
+	}
+	client, ok := h.ingester.Authenticate(r.Header.Get("Authorization"))
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req eventlog.IngestRequest
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxEventBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		http.Error(w, "invalid event body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	event, err := h.ingester.Ingest(client, req)
+	if err != nil {
+		http.Error(w, "invalid event: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	log.Printf("[EventsHandler] Recorded external event #%d from %s: %s", event.ID, client, event.Message)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(event); err != nil {
+		log.Printf("[EventsHandler] Failed to encode response: %v", err)
+	}
+}
+
+// parseEventQuery 쿼리 파라미터를 조회 조건으로 변환
+func parseEventQuery(values url.Values) (eventlog.Query, error) {
+	now := time.Now()
+	q := eventlog.Query{
+		Types:      splitParam(values.Get("type")),
+		Severities: splitParam(values.Get("severity")),
+		Sources:    splitParam(values.Get("source")),
+		Cluster:    values.Get("cluster"),
+		Resource:   values.Get("resource"),
+		Text:       values.Get("q"),
+		Limit:      defaultEventLimit,
+	}
+
+	var err error
+	if q.Since, err = parseTimeParam(values.Get("since"), now); err != nil {
+		return q, fmt.Errorf("invalid since: %w", err)
+	}
+	if q.Until, err = parseTimeParam(values.Get("until"), now); err != nil {
+		return q, fmt.Errorf("invalid until: %w", err)
+	}
+
+	if cursor := values.Get("cursor"); cursor != "" {
+		if q.Cursor, err = strconv.ParseUint(cursor, 10, 64); err != nil {
+			return q, fmt.Errorf("invalid cursor %q", cursor)
+		}
+	}
+
+	if limit := values.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			return q, fmt.Errorf("invalid limit %q", limit)
+		}
+		if n > maxEventLimit {
+			n = maxEventLimit
+		}
+		q.Limit = n
+	}
+
+	switch strings.ToLower(values.Get("sort")) {
+	case "", "desc":
+	case "asc":
+		q.Ascending = true
+	default:
+		return q, fmt.Errorf("invalid sort %q (asc or desc)", values.Get("sort"))
+	}
+
+	return q, nil
+}
+
+// parseTimeParam RFC3339 시각 또는 현재 기준 상대 기간("24h") 파싱
+func parseTimeParam(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("%q is neither RFC3339 nor a duration", value)
+}
+
+// splitParam 쉼표 구분 파라미터 분리
+func splitParam(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/failover"
+)
+
+// FailoverStatusResponse 자동 Failover 상태 응답
+type FailoverStatusResponse struct {
+	Rules    failover.Rules           `json:"rules"`
+	Clusters []failover.ClusterStatus `json:"clusters"`
+}
+
+// FailoverHandler 자동 Failover API 핸들러
+type FailoverHandler struct {
+	controller *failover.Controller
+}
+
+// NewFailoverHandler 새 Failover 핸들러 생성
+func NewFailoverHandler(controller *failover.Controller) *FailoverHandler {
+	return &FailoverHandler{
+		controller: controller,
+	}
+}
+
+// HandleStatus 정책과 클러스터별 격리 상태 조회
+// GET /api/failover/status
+func (h *FailoverHandler) HandleStatus(w http.ResponseWriter, r *http.Request) {
+	response := FailoverStatusResponse{
+		Rules:    h.controller.Rules(),
+		Clusters: h.controller.Status(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Printf("[FailoverHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
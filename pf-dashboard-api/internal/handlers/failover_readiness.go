@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/monitor"
+)
+
+// FailoverReadinessHandler 장애 대비 준비 상태 API 핸들러
+type FailoverReadinessHandler struct {
+	readiness *monitor.FailoverReadiness
+}
+
+// NewFailoverReadinessHandler 새 장애 대비 준비 상태 핸들러 생성
+func NewFailoverReadinessHandler(readiness *monitor.FailoverReadiness) *FailoverReadinessHandler {
+	return &FailoverReadinessHandler{
+		readiness: readiness,
+	}
+}
+
+// HandleReadiness 클러스터별 "이 클러스터가 장애나면" 판정 조회 (FAILOVER_READINESS_CACHE_TTL마다 다시 평가)
+// GET /api/failover/readiness?cluster=member1&namespace=default&name=web
+func (h *FailoverReadinessHandler) HandleReadiness(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	report := h.readiness.Evaluate(r.Context())
+
+	query := r.URL.Query()
+	cluster, namespace, name := query.Get("cluster"), query.Get("namespace"), query.Get("name")
+	matches := func(workloadNamespace, workloadName string) bool {
+		return (namespace == "" || workloadNamespace == namespace) && (name == "" || workloadName == name)
+	}
+	if namespace != "" || name != "" {
+		workloads := make([]monitor.WorkloadReadiness, 0)
+		for _, workload := range report.Workloads {
+			if matches(workload.Namespace, workload.Name) {
+				workloads = append(workloads, workload)
+			}
+		}
+		report.Workloads = workloads
+
+		// 용량 판정은 모든 워크로드 합계 기준이므로 시나리오 문제/생존 클러스터는 그대로 둠
+		for i, scenario := range report.Scenarios {
+			filtered := make([]monitor.WorkloadFailover, 0)
+			for _, workload := range scenario.Workloads {
+				if matches(workload.Namespace, workload.Name) {
+					filtered = append(filtered, workload)
+				}
+			}
+			report.Scenarios[i].Workloads = filtered
+		}
+	}
+	if cluster != "" {
+		scenarios := make([]monitor.FailoverScenario, 0)
+		for _, scenario := range report.Scenarios {
+			if scenario.FailedCluster == cluster {
+				scenarios = append(scenarios, scenario)
+			}
+		}
+		if len(scenarios) == 0 {
+			http.Error(w, "cluster not found", http.StatusNotFound)
+			return
+		}
+		report.Scenarios = scenarios
+		report.Verdict = scenarios[0].Verdict
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		log.Printf("[FailoverReadinessHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
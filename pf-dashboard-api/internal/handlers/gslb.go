@@ -4,31 +4,33 @@ import (
 	"encoding/json"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/minkyulee/pf-dashboard-backend/internal/gslb"
 )
 
 // GSLBHandler GSLB API 핸들러
 type GSLBHandler struct {
-	gslbClient *gslb.GSLBClient
+	provider gslb.Provider
 }
 
 // NewGSLBHandler 새 GSLB 핸들러 생성
-func NewGSLBHandler(gslbClient *gslb.GSLBClient) *GSLBHandler {
+func NewGSLBHandler(provider gslb.Provider) *GSLBHandler {
 	return &GSLBHandler{
-		gslbClient: gslbClient,
+		provider: provider,
 	}
 }
 
-// HandleGSLBPools GSLB 풀 목록 조회
+// HandleGSLBPools GSLB 목록 조회
 // GET /api/gslb/pools
 func (h *GSLBHandler) HandleGSLBPools(w http.ResponseWriter, r *http.Request) {
-	log.Printf("[GSLBHandler] Getting GSLB pools")
+	log.Printf("[GSLBHandler] Getting GSLB pools from %s provider", h.provider.Name())
 
-	pools, err := h.gslbClient.GetGSLBPools()
+	pools, err := h.provider.ListGSLBs(r.Context())
 	if err != nil {
 		log.Printf("[GSLBHandler] Failed to get GSLB pools: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeGSLBError(w, err)
 		return
 	}
 
@@ -47,10 +49,10 @@ func (h *GSLBHandler) HandleGSLBPools(w http.ResponseWriter, r *http.Request) {
 func (h *GSLBHandler) HandleGSLBDetails(w http.ResponseWriter, r *http.Request) {
 	log.Printf("[GSLBHandler] Getting GSLB details")
 
-	details, err := h.gslbClient.GetAllGSLBDetails()
+	details, err := gslb.ListPoolDetails(r.Context(), h.provider)
 	if err != nil {
 		log.Printf("[GSLBHandler] Failed to get GSLB details: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeGSLBError(w, err)
 		return
 	}
 
@@ -75,15 +77,15 @@ func (h *GSLBHandler) HandleGSLBByName(w http.ResponseWriter, r *http.Request) {
 
 	log.Printf("[GSLBHandler] Getting GSLB info for: %s", gslbName)
 
-	gslb, err := h.gslbClient.GetGSLBByName(gslbName)
+	info, err := gslb.FindGSLB(r.Context(), h.provider, gslbName)
 	if err != nil {
 		log.Printf("[GSLBHandler] Failed to get GSLB: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		writeGSLBError(w, err)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(gslb); err != nil {
+	if err := json.NewEncoder(w).Encode(info); err != nil {
 		log.Printf("[GSLBHandler] Failed to encode response: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -91,3 +93,96 @@ func (h *GSLBHandler) HandleGSLBByName(w http.ResponseWriter, r *http.Request) {
 
 	log.Printf("[GSLBHandler] Successfully sent GSLB info for: %s", gslbName)
 }
+
+// HandleGSLBHealth 모든 엔드포인트의 헬스 상태 조회
+// GET /api/gslb/health
+func (h *GSLBHandler) HandleGSLBHealth(w http.ResponseWriter, r *http.Request) {
+	health, err := h.provider.GetHealth(r.Context())
+	if err != nil {
+		log.Printf("[GSLBHandler] Failed to get GSLB health: %v", err)
+		writeGSLBError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(health); err != nil {
+		log.Printf("[GSLBHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("[GSLBHandler] Successfully sent health for %d endpoints", len(health))
+}
+
+// HandleGSLBSimulate 가상의 클라이언트 지역/장애 엔드포인트로 라우팅 결과 시뮬레이션
+// GET  /api/gslb/simulate?name=<gslb_name>&region=KR&unhealthy=<addr|pool>,...&useCurrentHealth=true
+// POST /api/gslb/simulate  (body: gslb.SimulationRequest)
+func (h *GSLBHandler) HandleGSLBSimulate(w http.ResponseWriter, r *http.Request) {
+	var req gslb.SimulationRequest
+
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		req.GSLBName = query.Get("name")
+		req.ClientRegion = query.Get("region")
+		for _, item := range strings.Split(query.Get("unhealthy"), ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				req.Unhealthy = append(req.Unhealthy, item)
+			}
+		}
+		req.UseCurrentHealth, _ = strconv.ParseBool(query.Get("useCurrentHealth"))
+	case http.MethodPost:
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if req.GSLBName == "" {
+		http.Error(w, "name parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	info, err := gslb.FindGSLB(r.Context(), h.provider, req.GSLBName)
+	if err != nil {
+		log.Printf("[GSLBHandler] Failed to get GSLB: %v", err)
+		writeGSLBError(w, err)
+		return
+	}
+
+	unhealthy := append([]string{}, req.Unhealthy...)
+	if req.UseCurrentHealth {
+		health, err := h.provider.GetHealth(r.Context())
+		if err != nil {
+			log.Printf("[GSLBHandler] Failed to get GSLB health: %v", err)
+			writeGSLBError(w, err)
+			return
+		}
+		for _, eh := range health {
+			if eh.Status == gslb.HealthUnhealthy {
+				unhealthy = append(unhealthy, eh.Address)
+			}
+		}
+	}
+
+	result := gslb.Simulate(*info, req.ClientRegion, unhealthy)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		log.Printf("[GSLBHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("[GSLBHandler] Simulated %s routing for %s (region=%q, unhealthy=%d)",
+		result.RoutingRule, req.GSLBName, req.ClientRegion, len(unhealthy))
+}
+
+// writeGSLBError 공급자 오류 종류에 맞는 상태 코드로 응답
+// (not-configured 503, not-found 404, upstream 장애/인증 실패 502, 타임아웃 504)
+func writeGSLBError(w http.ResponseWriter, err error) {
+	http.Error(w, err.Error(), gslb.HTTPStatus(err))
+}
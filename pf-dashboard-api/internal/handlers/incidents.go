@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/incident"
+)
+
+// AcknowledgeRequest 인시던트 확인 요청
+type AcknowledgeRequest struct {
+	ID   uint64 `json:"id"`
+	By   string `json:"by"`
+	Note string `json:"note,omitempty"`
+}
+
+// AnnotateRequest 인시던트 메모 요청
+type AnnotateRequest struct {
+	ID     uint64 `json:"id"`
+	Author string `json:"author"`
+	Text   string `json:"text"`
+}
+
+// IncidentsHandler 인시던트 API 핸들러
+type IncidentsHandler struct {
+	manager *incident.Manager
+}
+
+// NewIncidentsHandler 새 인시던트 핸들러 생성
+func NewIncidentsHandler(manager *incident.Manager) *IncidentsHandler {
+	return &IncidentsHandler{
+		manager: manager,
+	}
+}
+
+// HandleIncidents 인시던트 목록 조회 (최신순)
+// GET /api/incidents?status=active&cluster=member1
+func (h *IncidentsHandler) HandleIncidents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	status := strings.ToLower(query.Get("status"))
+	switch status {
+	case "", "active", incident.StatusOpen, incident.StatusAcknowledged, incident.StatusResolved:
+	default:
+		http.Error(w, "invalid status (open, acknowledged, resolved, active)", http.StatusBadRequest)
+		return
+	}
+
+	incidents := h.manager.List(incident.Filter{Status: status, Cluster: query.Get("cluster")})
+	writeIncidentJSON(w, incidents)
+}
+
+// HandleIncident 인시던트 상세 조회
+// GET /api/incidents/detail?id=1
+func (h *IncidentsHandler) HandleIncident(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := strconv.ParseUint(r.URL.Query().Get("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "id parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	inc, err := h.manager.Get(id)
+	if err != nil {
+		writeIncidentError(w, err)
+		return
+	}
+	writeIncidentJSON(w, inc)
+}
+
+// HandleAcknowledge 인시던트 확인
+// POST /api/incidents/ack {"id": 1, "by": "operator", "note": "..."}
+func (h *IncidentsHandler) HandleAcknowledge(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req AcknowledgeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.ID == 0 || req.By == "" {
+		http.Error(w, "id and by are required", http.StatusBadRequest)
+		return
+	}
+
+	inc, err := h.manager.Acknowledge(req.ID, req.By, req.Note)
+	if err != nil {
+		writeIncidentError(w, err)
+		return
+	}
+	writeIncidentJSON(w, inc)
+}
+
+// HandleAnnotate 인시던트 메모 추가
+// POST /api/incidents/annotate {"id": 1, "author": "operator", "text": "..."}
+func (h *IncidentsHandler) HandleAnnotate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req AnnotateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.ID == 0 || strings.TrimSpace(req.Text) == "" {
+		http.Error(w, "id and text are required", http.StatusBadRequest)
+		return
+	}
+
+	inc, err := h.manager.Annotate(req.ID, req.Author, req.Text)
+	if err != nil {
+		writeIncidentError(w, err)
+		return
+	}
+	writeIncidentJSON(w, inc)
+}
+
+// writeIncidentJSON JSON 응답 작성
+func writeIncidentJSON(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("[IncidentsHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// writeIncidentError 인시던트 오류를 HTTP 상태 코드로 변환
+func writeIncidentError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, incident.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, incident.ErrResolved):
+		status = http.StatusConflict
+	}
+	http.Error(w, err.Error(), status)
+}
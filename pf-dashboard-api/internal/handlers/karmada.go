@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/karmada"
+)
+
+// KarmadaHandler Karmada 전파 상태, 컨트롤 플레인 상태 API 핸들러
+type KarmadaHandler struct {
+	propagation  *karmada.PropagationView
+	controlPlane *karmada.ControlPlaneMonitor
+}
+
+// NewKarmadaHandler 새 Karmada 핸들러 생성
+func NewKarmadaHandler(propagation *karmada.PropagationView, controlPlane *karmada.ControlPlaneMonitor) *KarmadaHandler {
+	return &KarmadaHandler{
+		propagation:  propagation,
+		controlPlane: controlPlane,
+	}
+}
+
+// HandleHealth 컨트롤 플레인 상태 조회, POST면 즉시 다시 확인
+// GET  /api/karmada/health
+// POST /api/karmada/health
+func (h *KarmadaHandler) HandleHealth(w http.ResponseWriter, r *http.Request) {
+	if !h.controlPlane.Enabled() {
+		http.Error(w, "Karmada API server is not configured (KARMADA_CONTEXT)", http.StatusNotFound)
+		return
+	}
+
+	var status karmada.ControlPlaneStatus
+	switch r.Method {
+	case http.MethodGet:
+		if latest := h.controlPlane.Latest(); latest != nil {
+			status = *latest
+		} else {
+			status = h.controlPlane.Check(r.Context())
+		}
+	case http.MethodPost:
+		status = h.controlPlane.Check(r.Context())
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		log.Printf("[KarmadaHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// HandlePropagation 워크로드별 배치 규칙, 스케줄 결과, 적용된 override, Work 상태 조회
+// GET /api/karmada/propagation?kind=Deployment&namespace=tf-monitor&name=web
+func (h *KarmadaHandler) HandlePropagation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.propagation.Enabled() {
+		http.Error(w, "Karmada API server is not configured (KARMADA_CONTEXT)", http.StatusNotFound)
+		return
+	}
+
+	// 아직 갱신 전이면 즉시 조회
+	if !h.propagation.Refreshed() {
+		if err := h.propagation.Refresh(r.Context()); err != nil {
+			log.Printf("[KarmadaHandler] Failed to refresh propagation view: %v", err)
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+	}
+
+	query := r.URL.Query()
+	propagations := h.propagation.List(query.Get("kind"), query.Get("namespace"), query.Get("name"))
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(propagations); err != nil {
+		log.Printf("[KarmadaHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	"github.com/minkyulee/pf-dashboard-backend/internal/notify"
+)
+
+// NotifyTestRequest 테스트 알림 요청
+type NotifyTestRequest struct {
+	Receiver string `json:"receiver,omitempty"` // 비어 있으면 모든 수신자
+	Message  string `json:"message,omitempty"`
+}
+
+// NotifyHandler 알림 API 핸들러
+type NotifyHandler struct {
+	notifier *notify.Notifier
+	ingester *eventlog.Ingester // 테스트 알림 인증 (외부 이벤트 입력과 같은 토큰)
+}
+
+// NewNotifyHandler 새 알림 핸들러 생성
+func NewNotifyHandler(notifier *notify.Notifier, ingester *eventlog.Ingester) *NotifyHandler {
+	return &NotifyHandler{
+		notifier: notifier,
+		ingester: ingester,
+	}
+}
+
+// HandleStatus 알림 규칙과 수신자별 전송 현황 조회
+// GET /api/notifications/status
+func (h *NotifyHandler) HandleStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(h.notifier.Status()); err != nil {
+		log.Printf("[NotifyHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// HandleTest 테스트 알림 즉시 전송 (규칙은 무시하고 수신자별 전송 한도는 적용)
+// POST /api/notifications/test (Authorization: Bearer <token>) {"receiver": "ops-webhook", "message": "..."}
+func (h *NotifyHandler) HandleTest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.ingester.Enabled() {
+		http.Error(w, "test notifications disabled (EVENT_INGEST_TOKENS not set)", http.StatusForbidden)
+		return
+	}
+	client, ok := h.ingester.Authenticate(r.Header.Get("Authorization"))
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req NotifyTestRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxEventBodyBytes)).Decode(&req); err != nil {
+			http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	results, err := h.notifier.Test(r.Context(), req.Receiver, req.Message)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	log.Printf("[NotifyHandler] Sent test notification requested by %s", client)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(results); err != nil {
+		log.Printf("[NotifyHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
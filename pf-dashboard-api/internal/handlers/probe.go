@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/probe"
+)
+
+// HTTPProbeHandler 합성 HTTP 프로브 API 핸들러
+type HTTPProbeHandler struct {
+	httpProbe *probe.HTTPProbe
+}
+
+// NewHTTPProbeHandler 새 HTTP 프로브 핸들러 생성
+func NewHTTPProbeHandler(httpProbe *probe.HTTPProbe) *HTTPProbeHandler {
+	return &HTTPProbeHandler{
+		httpProbe: httpProbe,
+	}
+}
+
+// HandleProbes 대상별 최근 결과 또는 특정 대상의 이력 조회
+// GET /api/probes
+// GET /api/probes?target=<targetId>
+func (h *HTTPProbeHandler) HandleProbes(w http.ResponseWriter, r *http.Request) {
+	var results []probe.HTTPResult
+	if targetID := r.URL.Query().Get("target"); targetID != "" {
+		results = h.httpProbe.History(targetID)
+	} else {
+		results = h.httpProbe.Latest()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(results); err != nil {
+		log.Printf("[HTTPProbeHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
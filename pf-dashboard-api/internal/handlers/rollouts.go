@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/monitor"
+)
+
+// RolloutsHandler Deployment 롤아웃 API 핸들러
+type RolloutsHandler struct {
+	tracker *monitor.RolloutTracker
+}
+
+// NewRolloutsHandler 새 롤아웃 핸들러 생성
+func NewRolloutsHandler(tracker *monitor.RolloutTracker) *RolloutsHandler {
+	return &RolloutsHandler{
+		tracker: tracker,
+	}
+}
+
+// HandleRollouts 클러스터별 Deployment revision/이미지와 롤아웃 진행 상태 조회
+// GET /api/rollouts?namespace=tf-monitor&name=web
+func (h *RolloutsHandler) HandleRollouts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	rollouts := h.tracker.List(r.URL.Query().Get("namespace"), r.URL.Query().Get("name"))
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(rollouts); err != nil {
+		log.Printf("[RolloutsHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
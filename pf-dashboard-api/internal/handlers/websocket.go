@@ -7,12 +7,14 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	"github.com/minkyulee/pf-dashboard-backend/internal/incident"
+	"github.com/minkyulee/pf-dashboard-backend/internal/karmada"
 	"github.com/minkyulee/pf-dashboard-backend/internal/monitor"
 )
 
 // WebSocketMessage WebSocket 메시지 구조체
 type WebSocketMessage struct {
-	Type      string      `json:"type"` // clusters, events
+	Type      string      `json:"type"` // clusters, controlPlane, events, event, incidents, incident
 	Data      interface{} `json:"data"`
 	Timestamp string      `json:"timestamp"`
 }
@@ -21,14 +23,18 @@ type WebSocketMessage struct {
 type WebSocketHandler struct {
 	clusterMonitor monitor.ClusterMonitorInterface
 	eventLog       *eventlog.EventLog
+	incidents      *incident.Manager
+	controlPlane   *karmada.ControlPlaneMonitor
 	upgrader       websocket.Upgrader
 }
 
 // NewWebSocketHandler 새 WebSocket 핸들러 생성
-func NewWebSocketHandler(clusterMonitor monitor.ClusterMonitorInterface, eventLog *eventlog.EventLog) *WebSocketHandler {
+func NewWebSocketHandler(clusterMonitor monitor.ClusterMonitorInterface, eventLog *eventlog.EventLog, incidents *incident.Manager, controlPlane *karmada.ControlPlaneMonitor) *WebSocketHandler {
 	return &WebSocketHandler{
 		clusterMonitor: clusterMonitor,
 		eventLog:       eventLog,
+		incidents:      incidents,
+		controlPlane:   controlPlane,
 		upgrader: websocket.Upgrader{
 			ReadBufferSize:  1024,
 			WriteBufferSize: 1024,
@@ -64,6 +70,14 @@ func (h *WebSocketHandler) HandleWebSocket(w http.ResponseWriter, r *http.Reques
 	eventWatcher := h.eventLog.Watch()
 	defer h.eventLog.Unwatch(eventWatcher)
 
+	// 인시던트 변경 감지
+	incidentWatcher := h.incidents.Watch()
+	defer h.incidents.Unwatch(incidentWatcher)
+
+	// Karmada 컨트롤 플레인 상태 변경 감지
+	controlPlaneWatcher := h.controlPlane.Watch()
+	defer h.controlPlane.Unwatch(controlPlaneWatcher)
+
 	// Ping/Pong으로 연결 유지
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
@@ -87,6 +101,20 @@ func (h *WebSocketHandler) HandleWebSocket(w http.ResponseWriter, r *http.Reques
 				return
 			}
 
+		case status := <-controlPlaneWatcher:
+			// 컨트롤 플레인 상태 전송
+			if err := h.sendMessage(conn, "controlPlane", status); err != nil {
+				log.Printf("Failed to send control plane status: %v", err)
+				return
+			}
+
+		case inc := <-incidentWatcher:
+			// 인시던트 생성/변경 전송
+			if err := h.sendMessage(conn, "incident", inc); err != nil {
+				log.Printf("Failed to send incident: %v", err)
+				return
+			}
+
 		case <-ticker.C:
 			// Ping 전송
 			if err := conn.WriteControl(websocket.PingMessage, []byte{}, time.Now().Add(10*time.Second)); err != nil {
@@ -108,6 +136,13 @@ func (h *WebSocketHandler) sendInitialData(conn *websocket.Conn) {
 		log.Printf("[WebSocket] Successfully sent initial clusters")
 	}
 
+	// Karmada 컨트롤 플레인 상태 (확인 결과가 있을 때만)
+	if status := h.controlPlane.Latest(); status != nil {
+		if err := h.sendMessage(conn, "controlPlane", status); err != nil {
+			log.Printf("Failed to send initial control plane status: %v", err)
+		}
+	}
+
 	// 현재 이벤트 로그
 	events := h.eventLog.GetEvents()
 	log.Printf("[WebSocket] Initial events count: %d", len(events))
@@ -116,6 +151,12 @@ func (h *WebSocketHandler) sendInitialData(conn *websocket.Conn) {
 	} else {
 		log.Printf("[WebSocket] Successfully sent initial events")
 	}
+
+	// 진행 중인 인시던트
+	incidents := h.incidents.List(incident.Filter{Status: "active"})
+	if err := h.sendMessage(conn, "incidents", incidents); err != nil {
+		log.Printf("Failed to send initial incidents: %v", err)
+	}
 }
 
 // sendMessage 메시지 전송
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/monitor"
+)
+
+// WorkloadDriftHandler 클러스터 간 워크로드 drift API 핸들러
+type WorkloadDriftHandler struct {
+	detector *monitor.WorkloadDriftDetector
+}
+
+// NewWorkloadDriftHandler 새 워크로드 drift 핸들러 생성
+func NewWorkloadDriftHandler(detector *monitor.WorkloadDriftDetector) *WorkloadDriftHandler {
+	return &WorkloadDriftHandler{
+		detector: detector,
+	}
+}
+
+// HandleWorkloadDrift 마지막 워크로드 drift 보고 조회, POST면 즉시 다시 비교
+// GET  /api/workloads/drift?kind=Deployment&namespace=default&name=web
+// POST /api/workloads/drift
+func (h *WorkloadDriftHandler) HandleWorkloadDrift(w http.ResponseWriter, r *http.Request) {
+	var report *monitor.WorkloadDriftReport
+	switch r.Method {
+	case http.MethodGet:
+		report = h.detector.Latest()
+		if report == nil {
+			report = h.detector.Detect(r.Context())
+		}
+	case http.MethodPost:
+		report = h.detector.Detect(r.Context())
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	kind, namespace, name := query.Get("kind"), query.Get("namespace"), query.Get("name")
+	if kind != "" || namespace != "" || name != "" {
+		filtered := *report
+		filtered.Workloads = make([]monitor.WorkloadDrift, 0)
+		for _, workload := range report.Workloads {
+			if (kind == "" || workload.Kind == kind) &&
+				(namespace == "" || workload.Namespace == namespace) &&
+				(name == "" || workload.Name == name) {
+				filtered.Workloads = append(filtered.Workloads, workload)
+			}
+		}
+		filtered.InSync = len(filtered.Workloads) == 0
+		report = &filtered
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		log.Printf("[WorkloadDriftHandler] Failed to encode response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
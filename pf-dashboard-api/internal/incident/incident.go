@@ -0,0 +1,107 @@
+package incident
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+)
+
+// 인시던트 상태
+const (
+	StatusOpen         = "open"         // 감지됨
+	StatusAcknowledged = "acknowledged" // 운영자가 확인함
+	StatusResolved     = "resolved"     // 복구됨
+)
+
+var (
+	// ErrNotFound 인시던트가 없음 (보관 기간이 지난 경우 포함)
+	ErrNotFound = errors.New("incident not found")
+	// ErrResolved 이미 복구된 인시던트
+	ErrResolved = errors.New("incident is already resolved")
+)
+
+// Note 운영자 메모
+type Note struct {
+	Time   time.Time `json:"time"`
+	Author string    `json:"author,omitempty"`
+	Text   string    `json:"text"`
+}
+
+// Metrics 인시던트 대응 지표
+// 아직 발생하지 않은 단계는 생략
+type Metrics struct {
+	TimeToDetectMs   *int64 `json:"timeToDetectMs,omitempty"`   // 첫 이상 징후 → 감지
+	TimeToFailoverMs *int64 `json:"timeToFailoverMs,omitempty"` // 감지 → 첫 자동 Failover 조치
+	TimeToRecoverMs  *int64 `json:"timeToRecoverMs,omitempty"`  // 첫 이상 징후 → 복구
+}
+
+// Incident 클러스터 또는 워크로드 단위로 묶인 장애
+type Incident struct {
+	ID             uint64                     `json:"id"`
+	Title          string                     `json:"title"`  // 인시던트를 연 이벤트 메시지
+	Status         string                     `json:"status"` // open, acknowledged, resolved
+	Scope          string                     `json:"scope"`  // cluster/member1, workload/member1/default/web
+	ClusterID      string                     `json:"clusterId,omitempty"`
+	Object         *eventlog.ObjectReference  `json:"object,omitempty"` // 인시던트를 연 리소스
+	StartedAt      time.Time                  `json:"startedAt"`        // 첫 이상 징후 (warning 이상 이벤트)
+	DetectedAt     time.Time                  `json:"detectedAt"`       // 첫 critical 이벤트
+	AcknowledgedAt *time.Time                 `json:"acknowledgedAt,omitempty"`
+	AcknowledgedBy string                     `json:"acknowledgedBy,omitempty"`
+	FailoverAt     *time.Time                 `json:"failoverAt,omitempty"` // 첫 자동 Failover 조치
+	ResolvedAt     *time.Time                 `json:"resolvedAt,omitempty"`
+	Failing        []eventlog.ObjectReference `json:"failing"`    // 아직 복구되지 않은 리소스
+	EventCount     int                        `json:"eventCount"` // 연결된 이벤트 수 (events는 최근 maxEvents개)
+	Events         []eventlog.Event           `json:"events"`
+	Notes          []Note                     `json:"notes"`
+	Metrics        Metrics                    `json:"metrics"`
+
+	failing map[string]eventlog.ObjectReference
+	expires map[string]time.Time // 복구 신호가 없는 소스의 장애 만료 시각
+}
+
+// Active 아직 복구되지 않았는지
+func (inc *Incident) Active() bool {
+	return inc.Status != StatusResolved
+}
+
+// snapshot 외부로 전달할 복사본
+func (inc *Incident) snapshot() Incident {
+	out := *inc
+	out.Events = append([]eventlog.Event(nil), inc.Events...)
+	out.Notes = append([]Note{}, inc.Notes...)
+	out.Failing = make([]eventlog.ObjectReference, 0, len(inc.failing))
+	for _, obj := range inc.failing {
+		out.Failing = append(out.Failing, obj)
+	}
+	sort.Slice(out.Failing, func(i, j int) bool {
+		a, b := out.Failing[i], out.Failing[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Namespace+"/"+a.Name < b.Namespace+"/"+b.Name
+	})
+	out.failing = nil
+	out.expires = nil
+	return out
+}
+
+// updateMetrics 시각 정보로 대응 지표 계산
+func (inc *Incident) updateMetrics() {
+	inc.Metrics = Metrics{
+		TimeToDetectMs: millis(inc.DetectedAt.Sub(inc.StartedAt)),
+	}
+	if inc.FailoverAt != nil {
+		inc.Metrics.TimeToFailoverMs = millis(inc.FailoverAt.Sub(inc.DetectedAt))
+	}
+	if inc.ResolvedAt != nil {
+		inc.Metrics.TimeToRecoverMs = millis(inc.ResolvedAt.Sub(inc.StartedAt))
+	}
+}
+
+// millis 기간을 밀리초로 변환
+func millis(d time.Duration) *int64 {
+	ms := d.Milliseconds()
+	return &ms
+}
@@ -0,0 +1,580 @@
+package incident
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+)
+
+// maxEvents 인시던트당 보관하는 최근 이벤트 수
+const maxEvents = 200
+
+// workloadKinds 워크로드 단위 인시던트를 여는 리소스 종류
+var workloadKinds = map[string]bool{
+	"Deployment":  true,
+	"StatefulSet": true,
+	"DaemonSet":   true,
+	"Service":     true,
+}
+
+// globalSources 클러스터 정보 없이도 진행 중인 모든 인시던트와 관련된 이벤트 소스 (GSLB 변경 등)
+var globalSources = map[string]bool{
+	"failover":        true,
+	"gslb-reconciler": true,
+	"dns-probe":       true,
+}
+
+// recoverySources 같은 리소스의 복구(success) 이벤트를 보내는 상태 신호 소스
+// 그 외 소스(Kubernetes Event, 외부 입력, Alertmanager 등)의 장애는 staleAfter 동안 반복되지 않으면 만료
+var recoverySources = map[string]bool{
+	"monitor":        true,
+	"rollout":        true,
+	"workload-drift": true,
+	"karmada":        true,
+	"dns-probe":      true,
+	"http-probe":     true,
+}
+
+// Filter 인시던트 목록 조회 조건
+type Filter struct {
+	Status  string // open, acknowledged, resolved, active(open+acknowledged), 비어 있으면 전체
+	Cluster string
+}
+
+// Manager 이벤트를 구독해 인시던트를 열고, 관련 이벤트를 연결하고, 복구 시 닫음
+type Manager struct {
+	lookback    time.Duration // 감지 이전 이상 징후를 찾는 기간
+	historySize int           // 보관하는 복구된 인시던트 수
+	staleAfter  time.Duration // 복구 신호가 없는 장애가 만료되는 기간
+	store       *Store        // nil이면 메모리에만 보관
+
+	mu        sync.RWMutex
+	lastID    uint64
+	incidents []*Incident                 // ID 순서
+	active    map[string]*Incident        // 범위별 진행 중인 인시던트
+	pending   map[string][]eventlog.Event // 인시던트가 없는 범위의 최근 warning 이벤트
+	watchers  []chan Incident
+}
+
+// NewManagerFromEnv 환경변수 기반 인시던트 관리자 생성
+// INCIDENT_STORE=bolt(기본값)이면 INCIDENT_STORE_PATH 파일에 저장하고 시작 시 복원
+func NewManagerFromEnv() *Manager {
+	m := NewManager(getDurationEnv("INCIDENT_LOOKBACK", 10*time.Minute),
+		getIntEnv("INCIDENT_HISTORY", 100),
+		getDurationEnv("INCIDENT_STALE_AFTER", 30*time.Minute))
+
+	kind := strings.ToLower(os.Getenv("INCIDENT_STORE"))
+	switch kind {
+	case "memory":
+		log.Printf("[Incident] Using in-memory incident store")
+		return m
+	case "", "bolt":
+	default:
+		log.Printf("Warning: unknown INCIDENT_STORE %q, falling back to bolt", kind)
+	}
+
+	path := os.Getenv("INCIDENT_STORE_PATH")
+	if path == "" {
+		path = filepath.Join("data", "incidents.db")
+	}
+
+	store, err := OpenStore(path)
+	if err != nil {
+		log.Printf("Warning: failed to open incident store %s, incidents will not survive restarts: %v", path, err)
+		return m
+	}
+	if err := m.restore(store); err != nil {
+		log.Printf("Warning: failed to load incidents from %s: %v", path, err)
+	}
+	return m
+}
+
+// NewManager 새 인시던트 관리자 생성 (메모리 보관)
+func NewManager(lookback time.Duration, historySize int, staleAfter time.Duration) *Manager {
+	return &Manager{
+		lookback:    lookback,
+		historySize: historySize,
+		staleAfter:  staleAfter,
+		incidents:   make([]*Incident, 0),
+		active:      make(map[string]*Incident),
+		pending:     make(map[string][]eventlog.Event),
+		watchers:    make([]chan Incident, 0),
+	}
+}
+
+// restore 저장소의 인시던트를 불러오고 이후 변경을 저장소에 기록
+// 재시작 동안의 복구는 관측할 수 없으므로 진행 중인 장애도 staleAfter 후 만료되도록 설정
+func (m *Manager) restore(store *Store) error {
+	m.store = store
+
+	incidents, err := store.Load()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, inc := range incidents {
+		if inc.ID > m.lastID {
+			m.lastID = inc.ID
+		}
+		if !inc.Active() {
+			continue
+		}
+		for key := range inc.failing {
+			if _, ok := inc.expires[key]; !ok && m.staleAfter > 0 {
+				inc.expires[key] = now.Add(m.staleAfter)
+			}
+		}
+		m.active[inc.Scope] = inc
+	}
+	m.incidents = incidents
+	log.Printf("[Incident] Loaded %d incidents (%d active)", len(incidents), len(m.active))
+	return nil
+}
+
+// Run 이벤트 로그 구독 채널을 받아 인시던트 갱신 (블로킹)
+// 복구 신호가 없는 장애는 1분마다 만료 여부 확인
+func (m *Manager) Run(events <-chan eventlog.Event) {
+	log.Printf("[Incident] Started (lookback: %s, history: %d, stale after: %s)", m.lookback, m.historySize, m.staleAfter)
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			m.handle(event)
+		case now := <-ticker.C:
+			m.expireStale(now)
+		}
+	}
+}
+
+// handle 이벤트 하나를 인시던트에 반영
+func (m *Manager) handle(event eventlog.Event) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	scope := scopeOf(event)
+	if scope == "" {
+		if globalSources[event.Source] {
+			for _, inc := range m.active {
+				m.attach(inc, event)
+			}
+		}
+		return
+	}
+
+	inc := m.active[scope]
+	if inc == nil && strings.HasPrefix(scope, "workload/") {
+		// 워크로드 인시던트가 없으면 같은 클러스터의 인시던트에 연결
+		inc = m.active[clusterScope(event.ClusterID)]
+	}
+
+	if inc == nil {
+		if globalSources[event.Source] {
+			// 조치 이벤트만으로는 인시던트를 열지 않음
+			return
+		}
+		switch event.Severity {
+		case eventlog.SeverityCritical:
+			m.open(scope, event)
+		case eventlog.SeverityWarning:
+			m.remember(scope, event)
+		}
+		return
+	}
+
+	m.attach(inc, event)
+}
+
+// open 첫 critical 이벤트로 인시던트 시작
+func (m *Manager) open(scope string, event eventlog.Event) {
+	m.lastID++
+	inc := &Incident{
+		ID:         m.lastID,
+		Title:      event.Message,
+		Status:     StatusOpen,
+		Scope:      scope,
+		ClusterID:  event.ClusterID,
+		Object:     event.InvolvedObject,
+		StartedAt:  event.Time,
+		DetectedAt: event.Time,
+		Events:     []eventlog.Event{},
+		Notes:      []Note{},
+		failing:    make(map[string]eventlog.ObjectReference),
+		expires:    make(map[string]time.Time),
+	}
+
+	// 감지 이전의 이상 징후를 함께 연결하고 시작 시각으로 사용
+	for _, prior := range m.pending[scope] {
+		if event.Time.Sub(prior.Time) > m.lookback {
+			continue
+		}
+		if prior.Time.Before(inc.StartedAt) {
+			inc.StartedAt = prior.Time
+		}
+		inc.Events = append(inc.Events, prior)
+		inc.EventCount++
+	}
+	delete(m.pending, scope)
+
+	m.incidents = append(m.incidents, inc)
+	m.active[scope] = inc
+	log.Printf("[Incident] #%d opened (%s): %s", inc.ID, scope, inc.Title)
+
+	m.attach(inc, event)
+}
+
+// attach 이벤트 연결 후 Failover/복구 시각 갱신
+// 집계로 다시 전달된 이벤트(같은 ID)는 한 번만 연결하고, 장애/복구 추적은 매번 반영
+func (m *Manager) attach(inc *Incident, event eventlog.Event) {
+	if addEvent(inc, event) && isFailover(event) && inc.FailoverAt == nil {
+		t := event.Time
+		inc.FailoverAt = &t
+	}
+
+	// 상태 신호(monitor, probe 등)의 리소스별 장애/복구 추적
+	// Failover, GSLB 변경 같은 조치 이벤트는 복구 판단에 사용하지 않음
+	// 복구 신호를 보내지 않는 소스의 장애는 마지막 critical 이벤트 후 staleAfter가 지나면 만료
+	if obj := objectOf(event); obj != nil && !globalSources[event.Source] {
+		key := objectKey(event.ClusterID, obj)
+		switch {
+		case event.Severity == eventlog.SeverityCritical:
+			inc.failing[key] = *obj
+			if recoverySources[event.Source] || m.staleAfter <= 0 {
+				delete(inc.expires, key)
+			} else {
+				inc.expires[key] = lastSeen(event).Add(m.staleAfter)
+			}
+		case event.Type == "success":
+			delete(inc.failing, key)
+			delete(inc.expires, key)
+		}
+	}
+
+	if len(inc.failing) == 0 && inc.Active() {
+		m.resolve(inc, lastSeen(event))
+	}
+
+	inc.updateMetrics()
+	m.changed(inc)
+}
+
+// expireStale 복구 신호 없이 staleAfter가 지난 장애를 제거하고, 남은 장애가 없으면 인시던트 종료
+func (m *Manager) expireStale(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, inc := range m.active {
+		expired := 0
+		for key, at := range inc.expires {
+			if now.Before(at) {
+				continue
+			}
+			delete(inc.failing, key)
+			delete(inc.expires, key)
+			expired++
+		}
+		if expired == 0 {
+			continue
+		}
+
+		if len(inc.failing) == 0 {
+			inc.Notes = append(inc.Notes, Note{
+				Time: now,
+				Text: fmt.Sprintf("%s 동안 복구 신호나 새 장애 이벤트가 없어 자동 종료", m.staleAfter),
+			})
+			m.resolve(inc, now)
+		}
+		inc.updateMetrics()
+		m.changed(inc)
+	}
+}
+
+// resolve 모든 리소스가 복구된 인시던트 종료
+func (m *Manager) resolve(inc *Incident, at time.Time) {
+	inc.Status = StatusResolved
+	inc.ResolvedAt = &at
+	delete(m.active, inc.Scope)
+	log.Printf("[Incident] #%d resolved after %s", inc.ID, at.Sub(inc.StartedAt).Round(time.Second))
+
+	// 복구된 인시던트는 최근 historySize개만 보관
+	resolved := 0
+	for i := len(m.incidents) - 1; i >= 0; i-- {
+		if m.incidents[i].Active() {
+			continue
+		}
+		resolved++
+		if resolved > m.historySize {
+			m.forget(m.incidents[i].ID)
+			m.incidents = append(m.incidents[:i], m.incidents[i+1:]...)
+		}
+	}
+}
+
+// remember 인시던트가 없는 범위의 warning 이벤트 보관 (lookback 기간)
+func (m *Manager) remember(scope string, event eventlog.Event) {
+	kept := m.pending[scope][:0]
+	for _, prior := range m.pending[scope] {
+		if event.Time.Sub(prior.Time) <= m.lookback && prior.ID != event.ID {
+			kept = append(kept, prior)
+		}
+	}
+	m.pending[scope] = append(kept, event)
+}
+
+// List 조건에 맞는 인시던트 조회 (최신순)
+func (m *Manager) List(filter Filter) []Incident {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	incidents := make([]Incident, 0)
+	for i := len(m.incidents) - 1; i >= 0; i-- {
+		inc := m.incidents[i]
+		if !filter.matches(inc) {
+			continue
+		}
+		incidents = append(incidents, inc.snapshot())
+	}
+	return incidents
+}
+
+// Get ID로 인시던트 조회
+func (m *Manager) Get(id uint64) (Incident, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	inc, err := m.find(id)
+	if err != nil {
+		return Incident{}, err
+	}
+	return inc.snapshot(), nil
+}
+
+// Acknowledge 운영자 확인 (메모가 있으면 함께 기록)
+func (m *Manager) Acknowledge(id uint64, by, note string) (Incident, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	inc, err := m.find(id)
+	if err != nil {
+		return Incident{}, err
+	}
+	if !inc.Active() {
+		return Incident{}, ErrResolved
+	}
+
+	if inc.AcknowledgedAt == nil {
+		now := time.Now()
+		inc.Status = StatusAcknowledged
+		inc.AcknowledgedAt = &now
+		inc.AcknowledgedBy = by
+		log.Printf("[Incident] #%d acknowledged by %q", inc.ID, by)
+	}
+	if note != "" {
+		inc.Notes = append(inc.Notes, Note{Time: time.Now(), Author: by, Text: note})
+	}
+
+	m.changed(inc)
+	return inc.snapshot(), nil
+}
+
+// Annotate 메모 추가 (복구된 인시던트에도 가능)
+func (m *Manager) Annotate(id uint64, author, text string) (Incident, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	inc, err := m.find(id)
+	if err != nil {
+		return Incident{}, err
+	}
+
+	inc.Notes = append(inc.Notes, Note{Time: time.Now(), Author: author, Text: text})
+	m.changed(inc)
+	return inc.snapshot(), nil
+}
+
+// find ID로 인시던트 검색 (m.mu 보유 상태에서 호출)
+func (m *Manager) find(id uint64) (*Incident, error) {
+	i := sort.Search(len(m.incidents), func(i int) bool { return m.incidents[i].ID >= id })
+	if i == len(m.incidents) || m.incidents[i].ID != id {
+		return nil, fmt.Errorf("%w: #%d", ErrNotFound, id)
+	}
+	return m.incidents[i], nil
+}
+
+// Watch 인시던트 변경 감지 채널 등록
+func (m *Manager) Watch() chan Incident {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	watcher := make(chan Incident, 10)
+	m.watchers = append(m.watchers, watcher)
+	return watcher
+}
+
+// Unwatch 인시던트 감시 해제
+func (m *Manager) Unwatch(watcher chan Incident) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, w := range m.watchers {
+		if w == watcher {
+			close(w)
+			m.watchers = append(m.watchers[:i], m.watchers[i+1:]...)
+			break
+		}
+	}
+}
+
+// changed 변경된 인시던트를 저장소에 기록하고 watcher에 전달 (m.mu 보유 상태에서 호출)
+func (m *Manager) changed(inc *Incident) {
+	if m.store != nil {
+		if err := m.store.Save(inc); err != nil {
+			log.Printf("Warning: [Incident] Failed to save #%d: %v", inc.ID, err)
+		}
+	}
+	m.notify(inc)
+}
+
+// forget 보관 개수를 넘은 인시던트를 저장소에서 삭제 (m.mu 보유 상태에서 호출)
+func (m *Manager) forget(id uint64) {
+	if m.store == nil {
+		return
+	}
+	if err := m.store.Delete(id); err != nil {
+		log.Printf("Warning: [Incident] Failed to delete #%d: %v", id, err)
+	}
+}
+
+// notify 모든 watcher에게 변경된 인시던트 전달 (m.mu 보유 상태에서 호출)
+func (m *Manager) notify(inc *Incident) {
+	snapshot := inc.snapshot()
+	for _, watcher := range m.watchers {
+		select {
+		case watcher <- snapshot:
+		default:
+			// 버퍼가 가득 찬 경우 스킵
+		}
+	}
+}
+
+// matches 인시던트가 조회 조건을 만족하는지
+func (f Filter) matches(inc *Incident) bool {
+	switch f.Status {
+	case "":
+	case "active":
+		if !inc.Active() {
+			return false
+		}
+	default:
+		if !strings.EqualFold(inc.Status, f.Status) {
+			return false
+		}
+	}
+	return f.Cluster == "" || strings.EqualFold(inc.ClusterID, f.Cluster)
+}
+
+// addEvent 이벤트 연결 (이미 연결된 이벤트면 교체하고 false 반환)
+func addEvent(inc *Incident, event eventlog.Event) bool {
+	for i := range inc.Events {
+		if event.ID != 0 && inc.Events[i].ID == event.ID {
+			inc.Events[i] = event
+			return false
+		}
+	}
+
+	inc.Events = append(inc.Events, event)
+	inc.EventCount++
+	if len(inc.Events) > maxEvents {
+		inc.Events = inc.Events[len(inc.Events)-maxEvents:]
+	}
+	return true
+}
+
+// lastSeen 이벤트의 마지막 발생 시각 (집계 전 이벤트는 발생 시각)
+func lastSeen(event eventlog.Event) time.Time {
+	if event.LastSeen.IsZero() {
+		return event.Time
+	}
+	return event.LastSeen
+}
+
+// isFailover 실제 GSLB 변경을 수행한 자동 Failover 이벤트인지 (dry-run 제외)
+func isFailover(event eventlog.Event) bool {
+	return event.Source == "failover" && event.Type == "auto" && event.Attributes["mode"] != "dry-run"
+}
+
+// scopeOf 이벤트가 속한 인시던트 범위 (클러스터 정보가 없으면 빈 문자열)
+func scopeOf(event eventlog.Event) string {
+	if event.ClusterID == "" {
+		return ""
+	}
+	if obj := event.InvolvedObject; obj != nil && workloadKinds[obj.Kind] {
+		return strings.Join([]string{"workload", event.ClusterID, obj.Namespace, obj.Name}, "/")
+	}
+	return clusterScope(event.ClusterID)
+}
+
+// objectOf 이벤트의 장애 리소스 (리소스 정보가 없으면 클러스터)
+func objectOf(event eventlog.Event) *eventlog.ObjectReference {
+	if event.InvolvedObject != nil {
+		return event.InvolvedObject
+	}
+	if event.ClusterID != "" {
+		return &eventlog.ObjectReference{Kind: "Cluster", Name: event.ClusterID}
+	}
+	return nil
+}
+
+// clusterScope 클러스터 범위 키
+func clusterScope(clusterID string) string {
+	return "cluster/" + clusterID
+}
+
+// objectKey 장애 리소스 식별 키
+func objectKey(clusterID string, obj *eventlog.ObjectReference) string {
+	return strings.Join([]string{clusterID, obj.Kind, obj.Namespace, obj.Name}, "/")
+}
+
+// getDurationEnv 기간 환경변수 조회
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Warning: invalid %s %q, using %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return d
+}
+
+// getIntEnv 정수 환경변수 조회
+func getIntEnv(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Warning: invalid %s %q, using %d", key, value, defaultValue)
+		return defaultValue
+	}
+	return n
+}
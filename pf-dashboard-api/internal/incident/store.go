@@ -0,0 +1,121 @@
+package incident
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	bolt "go.etcd.io/bbolt"
+)
+
+// incidentsBucket 인시던트 버킷 이름 (키: 8바이트 big-endian 인시던트 ID)
+var incidentsBucket = []byte("incidents")
+
+// Store bbolt 파일 기반 인시던트 저장소 (재시작 후에도 이력과 진행 중인 인시던트 유지)
+type Store struct {
+	db *bolt.DB
+}
+
+// storedIncident 저장 형식 (복구 판단에 필요한 내부 상태 포함)
+type storedIncident struct {
+	Incident
+	FailingKeys map[string]eventlog.ObjectReference `json:"failingKeys"`
+	Expires     map[string]time.Time                `json:"expires,omitempty"`
+}
+
+// OpenStore 인시던트 저장소 열기 (파일과 상위 디렉터리가 없으면 생성)
+func OpenStore(path string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create incident store directory: %w", err)
+	}
+
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open incident store: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(incidentsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize incident store: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Save 인시던트 저장 (같은 ID면 덮어씀)
+func (s *Store) Save(inc *Incident) error {
+	record := storedIncident{
+		Incident:    *inc,
+		FailingKeys: inc.failing,
+		Expires:     inc.expires,
+	}
+	record.Failing = nil
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode incident: %w", err)
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(incidentsBucket).Put(idKey(inc.ID), data)
+	})
+}
+
+// Delete 보관 개수를 넘은 인시던트 삭제
+func (s *Store) Delete(id uint64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(incidentsBucket).Delete(idKey(id))
+	})
+}
+
+// Load 저장된 모든 인시던트를 ID 순서로 조회
+func (s *Store) Load() ([]*Incident, error) {
+	incidents := make([]*Incident, 0)
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(incidentsBucket).ForEach(func(k, v []byte) error {
+			var record storedIncident
+			if err := json.Unmarshal(v, &record); err != nil {
+				return fmt.Errorf("failed to decode incident %d: %w", binary.BigEndian.Uint64(k), err)
+			}
+
+			inc := record.Incident
+			inc.failing = record.FailingKeys
+			if inc.failing == nil {
+				inc.failing = make(map[string]eventlog.ObjectReference)
+			}
+			inc.expires = record.Expires
+			if inc.expires == nil {
+				inc.expires = make(map[string]time.Time)
+			}
+			incidents = append(incidents, &inc)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(incidents, func(i, j int) bool { return incidents[i].ID < incidents[j].ID })
+	return incidents, nil
+}
+
+// Close 저장소 닫기
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// idKey 인시던트 ID를 정렬 가능한 키로 변환
+func idKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
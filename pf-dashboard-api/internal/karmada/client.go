@@ -0,0 +1,95 @@
+package karmada
+
+import (
+	"log"
+	"os"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// Client Karmada API 서버 클라이언트 (Karmada CRD는 dynamic 클라이언트로 조회)
+type Client struct {
+	context string
+	kube    kubernetes.Interface // readyz, Lease 조회
+	dynamic dynamic.Interface
+	host    kubernetes.Interface // Karmada 컴포넌트가 실행되는 호스트 클러스터 (없으면 nil)
+}
+
+// NewClientFromEnv 환경변수 기반 Karmada API 서버 클라이언트 생성
+// KARMADA_KUBECONFIG 기본값은 KUBECONFIG, KARMADA_CONTEXT 기본값은 karmada-apiserver
+// KARMADA_HOST_CONTEXT(기본값 karmada-host)는 컴포넌트 Pod 조회에 사용
+// context가 없으면 비활성 클라이언트 반환
+func NewClientFromEnv() *Client {
+	kubeconfig := getEnvDefault("KARMADA_KUBECONFIG", os.Getenv("KUBECONFIG"))
+	if kubeconfig == "" {
+		kubeconfig = os.Getenv("HOME") + "/.kube/config"
+	}
+	contextName := getEnvDefault("KARMADA_CONTEXT", "karmada-apiserver")
+	hostContext := getEnvDefault("KARMADA_HOST_CONTEXT", "karmada-host")
+
+	config, err := clientcmd.LoadFromFile(kubeconfig)
+	if err != nil {
+		log.Printf("[Karmada] Failed to load kubeconfig: %v", err)
+		return &Client{context: contextName}
+	}
+	if _, exists := config.Contexts[contextName]; !exists {
+		log.Printf("[Karmada] Context %s not found in kubeconfig, Karmada API disabled", contextName)
+		return &Client{context: contextName}
+	}
+
+	restConfig, err := restConfigFor(kubeconfig, contextName)
+	if err != nil {
+		log.Printf("[Karmada] Failed to create config for %s: %v", contextName, err)
+		return &Client{context: contextName}
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		log.Printf("[Karmada] Failed to create client for %s: %v", contextName, err)
+		return &Client{context: contextName}
+	}
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		log.Printf("[Karmada] Failed to create client for %s: %v", contextName, err)
+		return &Client{context: contextName}
+	}
+	log.Printf("[Karmada] Successfully connected to %s", contextName)
+
+	client := &Client{context: contextName, kube: kubeClient, dynamic: dynamicClient}
+
+	if _, exists := config.Contexts[hostContext]; !exists {
+		log.Printf("[Karmada] Host context %s not found in kubeconfig, component Pod checks disabled", hostContext)
+		return client
+	}
+	hostConfig, err := restConfigFor(kubeconfig, hostContext)
+	if err == nil {
+		client.host, err = kubernetes.NewForConfig(hostConfig)
+	}
+	if err != nil {
+		log.Printf("[Karmada] Failed to create client for %s: %v", hostContext, err)
+		return client
+	}
+	log.Printf("[Karmada] Successfully connected to %s", hostContext)
+	return client
+}
+
+// NewClient 주어진 클라이언트로 Karmada 클라이언트 생성 (host는 nil 가능)
+func NewClient(contextName string, kube kubernetes.Interface, dynamicClient dynamic.Interface, host kubernetes.Interface) *Client {
+	return &Client{context: contextName, kube: kube, dynamic: dynamicClient, host: host}
+}
+
+// Enabled Karmada API 서버에 연결되었는지
+func (c *Client) Enabled() bool {
+	return c.dynamic != nil
+}
+
+// restConfigFor kubeconfig context의 REST 설정
+func restConfigFor(kubeconfig, contextName string) (*rest.Config, error) {
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig},
+		&clientcmd.ConfigOverrides{CurrentContext: contextName},
+	).ClientConfig()
+}
@@ -0,0 +1,542 @@
+package karmada
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ControlPlaneID Karmada 컨트롤 플레인 이벤트의 클러스터 ID
+const ControlPlaneID = "karmada"
+
+var clusterGVR = schema.GroupVersionResource{Group: "cluster.karmada.io", Version: "v1alpha1", Resource: "clusters"}
+
+const (
+	// Karmada가 member 클러스터별 Lease를 갱신하는 네임스페이스
+	clusterLeaseNamespace = "karmada-cluster"
+
+	// Lease에 기간이 없을 때 사용하는 Karmada 기본값
+	defaultClusterLeaseDuration = 40 * time.Second
+
+	defaultKarmadaComponents = "karmada-apiserver,karmada-scheduler,karmada-controller-manager,karmada-webhook,etcd"
+)
+
+// HealthCheck API 서버 readyz 개별 검사 결과
+type HealthCheck struct {
+	Name string `json:"name"`
+	OK   bool   `json:"ok"`
+}
+
+// APIServerHealth Karmada API 서버 상태
+type APIServerHealth struct {
+	Ready  bool          `json:"ready"`
+	Checks []HealthCheck `json:"checks"` // etcd, informer-sync 등 /readyz?verbose 결과
+	Error  string        `json:"error,omitempty"`
+}
+
+// ComponentHealth Karmada 컴포넌트 Pod 상태
+type ComponentHealth struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"` // ready, degraded, down, unknown
+	Ready    int    `json:"ready"`  // Ready Pod 수
+	Total    int    `json:"total"`
+	Restarts int32  `json:"restarts"`
+	Message  string `json:"message,omitempty"`
+}
+
+// MemberClusterStatus Karmada Cluster 객체 상태
+type MemberClusterStatus struct {
+	Name              string     `json:"name"`
+	SyncMode          string     `json:"syncMode"` // Push, Pull
+	Ready             string     `json:"ready"`    // True, False, Unknown
+	Reason            string     `json:"reason,omitempty"`
+	Message           string     `json:"message,omitempty"`
+	KubernetesVersion string     `json:"kubernetesVersion,omitempty"`
+	Taints            []string   `json:"taints"` // key=value:effect
+	LeaseRenewTime    *time.Time `json:"leaseRenewTime,omitempty"`
+	LeaseExpired      bool       `json:"leaseExpired"`
+}
+
+// ControlPlaneStatus Karmada 컨트롤 플레인 상태 (member 클러스터와 나란히 표시)
+type ControlPlaneStatus struct {
+	ID         string                `json:"id"`
+	Name       string                `json:"name"`
+	Status     string                `json:"status"`           // ready, degraded, failure
+	Reason     string                `json:"reason,omitempty"` // degraded/failure 원인
+	CheckedAt  time.Time             `json:"checkedAt"`
+	APIServer  APIServerHealth       `json:"apiServer"`
+	Components []ComponentHealth     `json:"components"`
+	Clusters   []MemberClusterStatus `json:"clusters"`
+}
+
+// karmadaCluster Cluster 객체에서 사용하는 필드
+type karmadaCluster struct {
+	Metadata metav1.ObjectMeta `json:"metadata"`
+	Spec     struct {
+		SyncMode string `json:"syncMode"`
+		Taints   []struct {
+			Key    string `json:"key"`
+			Value  string `json:"value,omitempty"`
+			Effect string `json:"effect"`
+		} `json:"taints,omitempty"`
+	} `json:"spec"`
+	Status struct {
+		KubernetesVersion string      `json:"kubernetesVersion,omitempty"`
+		Conditions        []Condition `json:"conditions,omitempty"`
+	} `json:"status"`
+}
+
+// controlPlaneProblem 이벤트로 보고하는 이상 징후 하나
+type controlPlaneProblem struct {
+	severity string
+	message  string
+	recovery string // 해소 시 이벤트 메시지
+	object   eventlog.ObjectReference
+}
+
+// ControlPlaneMonitor Karmada API 서버, 컴포넌트, Cluster 객체 상태를 주기적으로 확인
+type ControlPlaneMonitor struct {
+	client          *Client
+	eventLog        *eventlog.EventLog
+	systemNamespace string
+	components      []string
+	interval        time.Duration
+
+	runMu    sync.Mutex // Check 동시 실행 방지
+	mu       sync.RWMutex
+	latest   *ControlPlaneStatus
+	problems map[string]controlPlaneProblem // 이벤트로 보고한 이상 징후
+	watchers []chan ControlPlaneStatus
+}
+
+// NewControlPlaneMonitorFromEnv 환경변수 기반 컨트롤 플레인 모니터 생성
+// KARMADA_SYSTEM_NAMESPACE 기본값은 karmada-system
+// KARMADA_COMPONENTS 기본값은 karmada-apiserver, karmada-scheduler, karmada-controller-manager, karmada-webhook, etcd (app 라벨 값)
+// KARMADA_HEALTH_INTERVAL 기본값은 15s
+func NewControlPlaneMonitorFromEnv(client *Client, eventLog *eventlog.EventLog) *ControlPlaneMonitor {
+	return &ControlPlaneMonitor{
+		client:          client,
+		eventLog:        eventLog,
+		systemNamespace: getEnvDefault("KARMADA_SYSTEM_NAMESPACE", "karmada-system"),
+		components:      splitList(getEnvDefault("KARMADA_COMPONENTS", defaultKarmadaComponents)),
+		interval:        getDurationEnv("KARMADA_HEALTH_INTERVAL", 15*time.Second),
+		problems:        make(map[string]controlPlaneProblem),
+	}
+}
+
+// Enabled Karmada API 서버를 확인할 수 있는지
+func (m *ControlPlaneMonitor) Enabled() bool {
+	return m.client.Enabled()
+}
+
+// Run 주기적으로 상태 확인 (블로킹)
+func (m *ControlPlaneMonitor) Run() {
+	if !m.Enabled() || m.interval <= 0 {
+		log.Printf("[Karmada] Control plane monitor disabled")
+		return
+	}
+
+	log.Printf("[Karmada] Control plane monitor started (namespace: %s, components: %s, interval: %s)",
+		m.systemNamespace, strings.Join(m.components, ", "), m.interval)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	m.Check(context.Background())
+	for range ticker.C {
+		m.Check(context.Background())
+	}
+}
+
+// Latest 마지막 확인 결과 (아직 없으면 nil)
+func (m *ControlPlaneMonitor) Latest() *ControlPlaneStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.latest
+}
+
+// Check API 서버, 컴포넌트, Cluster 객체를 확인하고 새 이상 징후와 복구를 이벤트로 기록
+func (m *ControlPlaneMonitor) Check(ctx context.Context) ControlPlaneStatus {
+	m.runMu.Lock()
+	defer m.runMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	status := ControlPlaneStatus{
+		ID:         ControlPlaneID,
+		Name:       "Karmada Control Plane",
+		CheckedAt:  time.Now(),
+		APIServer:  m.checkAPIServer(ctx),
+		Components: m.checkComponents(ctx),
+		Clusters:   []MemberClusterStatus{},
+	}
+	clustersKnown := false
+	if status.APIServer.Ready {
+		clusters, err := m.checkClusters(ctx, status.CheckedAt)
+		if err != nil {
+			log.Printf("Warning: [Karmada] Failed to check Cluster objects: %v", err)
+		}
+		status.Clusters = clusters
+		clustersKnown = err == nil
+	}
+
+	// 확인하지 못한 항목은 이전에 보고한 이상 징후 유지 (복구로 보지 않음)
+	problems := m.problemsOf(status)
+	unknown := make(map[string]bool)
+	for _, c := range status.Components {
+		if c.Status == "unknown" {
+			unknown["component/"+c.Name] = true
+		}
+	}
+	for key, p := range m.problems {
+		if unknown[key] || (!clustersKnown && strings.HasPrefix(key, "cluster/")) {
+			problems[key] = p
+		}
+	}
+	status.Status = "ready"
+	reasons := make([]string, 0, len(problems))
+	for _, key := range sortedKeys(problems) {
+		p := problems[key]
+		if p.severity == eventlog.SeverityCritical {
+			status.Status = "failure"
+		} else if status.Status == "ready" {
+			status.Status = "degraded"
+		}
+		reasons = append(reasons, p.message)
+	}
+	status.Reason = strings.Join(reasons, "; ")
+
+	m.reportEvents(problems)
+
+	m.mu.Lock()
+	m.latest = &status
+	for _, watcher := range m.watchers {
+		select {
+		case watcher <- status:
+		default:
+		}
+	}
+	m.mu.Unlock()
+	return status
+}
+
+// Watch 상태 확인 결과 구독
+func (m *ControlPlaneMonitor) Watch() chan ControlPlaneStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	watcher := make(chan ControlPlaneStatus, 10)
+	m.watchers = append(m.watchers, watcher)
+	return watcher
+}
+
+// Unwatch 구독 해제
+func (m *ControlPlaneMonitor) Unwatch(watcher chan ControlPlaneStatus) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, w := range m.watchers {
+		if w == watcher {
+			m.watchers = append(m.watchers[:i], m.watchers[i+1:]...)
+			close(watcher)
+			break
+		}
+	}
+}
+
+// checkAPIServer /readyz?verbose로 API 서버와 etcd 등 개별 검사 확인
+func (m *ControlPlaneMonitor) checkAPIServer(ctx context.Context) APIServerHealth {
+	health := APIServerHealth{Checks: []HealthCheck{}}
+
+	restClient := m.client.kube.Discovery().RESTClient()
+	if restClient == nil {
+		health.Error = "readyz is not available"
+		return health
+	}
+
+	body, err := restClient.Get().AbsPath("/readyz").Param("verbose", "true").DoRaw(ctx)
+	// readyz가 실패하면 503과 함께 같은 형식의 본문을 반환
+	for _, line := range strings.Split(string(body), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "[+]"):
+			health.Checks = append(health.Checks, HealthCheck{Name: checkName(line), OK: true})
+		case strings.HasPrefix(line, "[-]"):
+			health.Checks = append(health.Checks, HealthCheck{Name: checkName(line), OK: false})
+		}
+	}
+	if err != nil {
+		health.Error = err.Error()
+		return health
+	}
+
+	health.Ready = true
+	return health
+}
+
+// checkName readyz 결과 줄에서 검사 이름 추출 ("[+]etcd ok" -> etcd)
+func checkName(line string) string {
+	name := strings.TrimSpace(line[3:])
+	if i := strings.IndexByte(name, ' '); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// checkComponents 호스트 클러스터의 컴포넌트 Pod 상태 확인 (app 라벨로 매칭)
+func (m *ControlPlaneMonitor) checkComponents(ctx context.Context) []ComponentHealth {
+	components := make([]ComponentHealth, 0, len(m.components))
+	if m.client.host == nil {
+		return components
+	}
+
+	for _, name := range m.components {
+		component := ComponentHealth{Name: name}
+
+		pods, err := m.client.host.CoreV1().Pods(m.systemNamespace).List(ctx, metav1.ListOptions{LabelSelector: "app=" + name})
+		if err != nil {
+			component.Status = "unknown"
+			component.Message = err.Error()
+			components = append(components, component)
+			continue
+		}
+
+		notReady := make([]string, 0)
+		for _, pod := range pods.Items {
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+			component.Total++
+			for _, cs := range pod.Status.ContainerStatuses {
+				component.Restarts += cs.RestartCount
+			}
+			if podReady(&pod) {
+				component.Ready++
+			} else {
+				notReady = append(notReady, pod.Name)
+			}
+		}
+
+		switch {
+		case component.Total == 0:
+			component.Status = "down"
+			component.Message = "no pods found"
+		case component.Ready == 0:
+			component.Status = "down"
+			component.Message = "not ready: " + strings.Join(notReady, ", ")
+		case component.Ready < component.Total:
+			component.Status = "degraded"
+			component.Message = "not ready: " + strings.Join(notReady, ", ")
+		default:
+			component.Status = "ready"
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// podReady Pod Ready 조건이 True인지
+func podReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// checkClusters Cluster 객체의 Ready 조건, taint, 동기화 모드와 Lease 갱신 확인
+func (m *ControlPlaneMonitor) checkClusters(ctx context.Context, now time.Time) ([]MemberClusterStatus, error) {
+	list, err := m.client.dynamic.Resource(clusterGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return []MemberClusterStatus{}, fmt.Errorf("list clusters: %w", err)
+	}
+
+	leases, err := m.client.kube.CoordinationV1().Leases(clusterLeaseNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("Warning: [Karmada] Failed to list cluster leases: %v", err)
+	}
+
+	clusters := make([]MemberClusterStatus, 0, len(list.Items))
+	for _, item := range list.Items {
+		var cluster karmadaCluster
+		if err := decode(item.Object, &cluster); err != nil {
+			log.Printf("Warning: [Karmada] Failed to decode cluster %s: %v", item.GetName(), err)
+			continue
+		}
+
+		status := MemberClusterStatus{
+			Name:              cluster.Metadata.Name,
+			SyncMode:          cluster.Spec.SyncMode,
+			Ready:             string(metav1.ConditionUnknown),
+			KubernetesVersion: cluster.Status.KubernetesVersion,
+			Taints:            make([]string, 0, len(cluster.Spec.Taints)),
+		}
+		for _, cond := range cluster.Status.Conditions {
+			if cond.Type == "Ready" {
+				status.Ready, status.Reason, status.Message = cond.Status, cond.Reason, cond.Message
+			}
+		}
+		for _, taint := range cluster.Spec.Taints {
+			text := taint.Key
+			if taint.Value != "" {
+				text += "=" + taint.Value
+			}
+			status.Taints = append(status.Taints, text+":"+taint.Effect)
+		}
+
+		if leases != nil {
+			for _, lease := range leases.Items {
+				if lease.Name != status.Name || lease.Spec.RenewTime == nil {
+					continue
+				}
+				renewTime := lease.Spec.RenewTime.Time
+				duration := defaultClusterLeaseDuration
+				if lease.Spec.LeaseDurationSeconds != nil {
+					duration = time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+				}
+				status.LeaseRenewTime = &renewTime
+				status.LeaseExpired = now.Sub(renewTime) > duration
+			}
+		}
+
+		clusters = append(clusters, status)
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].Name < clusters[j].Name })
+	return clusters, nil
+}
+
+// problemsOf 상태에서 이상 징후 추출
+func (m *ControlPlaneMonitor) problemsOf(status ControlPlaneStatus) map[string]controlPlaneProblem {
+	problems := make(map[string]controlPlaneProblem)
+
+	if !status.APIServer.Ready {
+		failed := make([]string, 0)
+		for _, check := range status.APIServer.Checks {
+			if !check.OK {
+				failed = append(failed, check.Name)
+			}
+		}
+		message := "Karmada API server is not ready"
+		if len(failed) > 0 {
+			message += " (failed: " + strings.Join(failed, ", ") + ")"
+		} else if status.APIServer.Error != "" {
+			message += " - " + status.APIServer.Error
+		}
+		problems["apiserver"] = controlPlaneProblem{
+			severity: eventlog.SeverityCritical,
+			message:  message,
+			recovery: "Karmada API server RECOVERED",
+			object:   eventlog.ObjectReference{Kind: "Component", Namespace: m.systemNamespace, Name: "karmada-apiserver"},
+		}
+	}
+
+	for _, c := range status.Components {
+		if c.Status == "ready" || c.Status == "unknown" {
+			continue
+		}
+		severity := eventlog.SeverityWarning
+		if c.Status == "down" && (c.Name == "etcd" || c.Name == "karmada-apiserver") {
+			severity = eventlog.SeverityCritical
+		}
+		problems["component/"+c.Name] = controlPlaneProblem{
+			severity: severity,
+			message:  fmt.Sprintf("Karmada component %s is %s (%d/%d pods ready)", c.Name, c.Status, c.Ready, c.Total),
+			recovery: fmt.Sprintf("Karmada component %s RECOVERED", c.Name),
+			object:   eventlog.ObjectReference{Kind: "Component", Namespace: m.systemNamespace, Name: c.Name},
+		}
+	}
+
+	for _, c := range status.Clusters {
+		if c.Ready != string(metav1.ConditionTrue) {
+			message := fmt.Sprintf("Karmada Cluster %s (%s) is not ready", c.Name, c.SyncMode)
+			if c.Reason != "" {
+				message += " - " + c.Reason
+			}
+			problems["cluster/"+c.Name+"/ready"] = controlPlaneProblem{
+				severity: eventlog.SeverityWarning,
+				message:  message,
+				recovery: fmt.Sprintf("Karmada Cluster %s is Ready", c.Name),
+				object:   eventlog.ObjectReference{Kind: "Cluster", Name: c.Name},
+			}
+		}
+		if c.LeaseExpired {
+			problems["cluster/"+c.Name+"/lease"] = controlPlaneProblem{
+				severity: eventlog.SeverityWarning,
+				message:  fmt.Sprintf("Karmada Cluster %s lease not renewed since %s", c.Name, c.LeaseRenewTime.Format(time.RFC3339)),
+				recovery: fmt.Sprintf("Karmada Cluster %s lease renewed", c.Name),
+				object:   eventlog.ObjectReference{Kind: "Lease", Namespace: clusterLeaseNamespace, Name: c.Name},
+			}
+		}
+	}
+	return problems
+}
+
+// reportEvents 새 이상 징후와 해소된 이상 징후를 이벤트로 기록
+func (m *ControlPlaneMonitor) reportEvents(problems map[string]controlPlaneProblem) {
+	for _, key := range sortedKeys(problems) {
+		p := problems[key]
+		if _, reported := m.problems[key]; reported {
+			continue
+		}
+
+		icon := "🟠"
+		if p.severity == eventlog.SeverityCritical {
+			icon = "🔴"
+		}
+		object := p.object
+		message := icon + " " + p.message
+		log.Printf("[ALERT] %s", message)
+		m.eventLog.Record(eventlog.Event{
+			Type:           "critical",
+			Severity:       p.severity,
+			Message:        message,
+			Source:         "karmada",
+			ClusterID:      ControlPlaneID,
+			InvolvedObject: &object,
+			Attributes:     map[string]string{"check": key},
+		})
+	}
+
+	for _, key := range sortedKeys(m.problems) {
+		if _, ok := problems[key]; ok {
+			continue
+		}
+		p := m.problems[key]
+		object := p.object
+		message := "✅ " + p.recovery
+		log.Printf("[INFO] %s", message)
+		m.eventLog.Record(eventlog.Event{
+			Type:           "success",
+			Severity:       eventlog.SeverityInfo,
+			Message:        message,
+			Source:         "karmada",
+			ClusterID:      ControlPlaneID,
+			InvolvedObject: &object,
+			Attributes:     map[string]string{"check": key},
+		})
+	}
+
+	m.problems = problems
+}
+
+// sortedKeys 이상 징후 키 (정렬)
+func sortedKeys(problems map[string]controlPlaneProblem) []string {
+	keys := make([]string, 0, len(problems))
+	for key := range problems {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,788 @@
+package karmada
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/monitor"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	propagationPolicyGVR        = schema.GroupVersionResource{Group: "policy.karmada.io", Version: "v1alpha1", Resource: "propagationpolicies"}
+	clusterPropagationPolicyGVR = schema.GroupVersionResource{Group: "policy.karmada.io", Version: "v1alpha1", Resource: "clusterpropagationpolicies"}
+	overridePolicyGVR           = schema.GroupVersionResource{Group: "policy.karmada.io", Version: "v1alpha1", Resource: "overridepolicies"}
+	resourceBindingGVR          = schema.GroupVersionResource{Group: "work.karmada.io", Version: "v1alpha2", Resource: "resourcebindings"}
+	workGVR                     = schema.GroupVersionResource{Group: "work.karmada.io", Version: "v1alpha1", Resource: "works"}
+)
+
+const (
+	// Work는 클러스터별 실행 네임스페이스(karmada-es-<cluster>)에 생성됨
+	executionNamespacePrefix = "karmada-es-"
+
+	// 클러스터 장애 시 Karmada가 붙이는 taint
+	clusterNotReadyTaint    = "cluster.karmada.io/not-ready"
+	clusterUnreachableTaint = "cluster.karmada.io/unreachable"
+
+	// ResourceBinding에 붙는 전파 정책 라벨/어노테이션
+	propagationPolicyNameKey        = "propagationpolicy.karmada.io/name"
+	propagationPolicyNamespaceKey   = "propagationpolicy.karmada.io/namespace"
+	clusterPropagationPolicyNameKey = "clusterpropagationpolicy.karmada.io/name"
+)
+
+// ClusterAffinity 클러스터 선택 규칙
+type ClusterAffinity struct {
+	LabelSelector   *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	ClusterNames    []string              `json:"clusterNames,omitempty"`
+	ExcludeClusters []string              `json:"exclude,omitempty"`
+}
+
+// NamedClusterAffinity 순서대로 시도하는 클러스터 그룹
+type NamedClusterAffinity struct {
+	AffinityName string `json:"affinityName"`
+	ClusterAffinity
+}
+
+// ClusterToleration 클러스터 taint 허용
+type ClusterToleration struct {
+	Key               string `json:"key,omitempty"`
+	Operator          string `json:"operator,omitempty"`
+	Value             string `json:"value,omitempty"`
+	Effect            string `json:"effect,omitempty"`
+	TolerationSeconds *int64 `json:"tolerationSeconds,omitempty"`
+}
+
+// SpreadConstraint 클러스터 분산 제약
+type SpreadConstraint struct {
+	SpreadByField string `json:"spreadByField,omitempty"`
+	SpreadByLabel string `json:"spreadByLabel,omitempty"`
+	MaxGroups     int    `json:"maxGroups,omitempty"`
+	MinGroups     int    `json:"minGroups,omitempty"`
+}
+
+// StaticClusterWeight 클러스터별 정적 가중치
+type StaticClusterWeight struct {
+	TargetCluster ClusterAffinity `json:"targetCluster"`
+	Weight        int64           `json:"weight"`
+}
+
+// WeightPreference replica 분배 가중치
+type WeightPreference struct {
+	StaticWeightList []StaticClusterWeight `json:"staticWeightList,omitempty"`
+	DynamicWeight    string                `json:"dynamicWeight,omitempty"`
+}
+
+// ReplicaScheduling replica 스케줄링 방식
+type ReplicaScheduling struct {
+	ReplicaSchedulingType     string            `json:"replicaSchedulingType,omitempty"`     // Duplicated, Divided
+	ReplicaDivisionPreference string            `json:"replicaDivisionPreference,omitempty"` // Aggregated, Weighted
+	WeightPreference          *WeightPreference `json:"weightPreference,omitempty"`
+}
+
+// Placement 전파 정책의 배치 규칙
+type Placement struct {
+	ClusterAffinity    *ClusterAffinity       `json:"clusterAffinity,omitempty"`
+	ClusterAffinities  []NamedClusterAffinity `json:"clusterAffinities,omitempty"`
+	ClusterTolerations []ClusterToleration    `json:"clusterTolerations,omitempty"`
+	SpreadConstraints  []SpreadConstraint     `json:"spreadConstraints,omitempty"`
+	ReplicaScheduling  *ReplicaScheduling     `json:"replicaScheduling,omitempty"`
+}
+
+// PolicyRef 워크로드를 선택한 전파 정책
+type PolicyRef struct {
+	Kind      string `json:"kind"` // PropagationPolicy, ClusterPropagationPolicy
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Priority  int32  `json:"priority"`
+}
+
+// TargetCluster 스케줄러가 선택한 클러스터와 replica 수
+type TargetCluster struct {
+	Name     string `json:"name"`
+	Replicas int32  `json:"replicas,omitempty"`
+}
+
+// Condition ResourceBinding/Work 조건
+type Condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// AppliedOverride 워크로드에 적용되는 OverridePolicy 규칙 하나
+type AppliedOverride struct {
+	Policy        string           `json:"policy"`                  // namespace/name
+	TargetCluster *ClusterAffinity `json:"targetCluster,omitempty"` // 없으면 모든 클러스터
+	Overriders    []string         `json:"overriders"`              // "image: replace registry=...", "plaintext: replace /spec/replicas" ...
+}
+
+// WorkStatus 클러스터별 Work 적용 상태
+type WorkStatus struct {
+	Cluster string                 `json:"cluster"`
+	Work    string                 `json:"work"`
+	Applied bool                   `json:"applied"`
+	Reason  string                 `json:"reason,omitempty"`  // Applied 조건 원인
+	Message string                 `json:"message,omitempty"` // Applied 조건 설명
+	Health  string                 `json:"health,omitempty"`  // Healthy, Unhealthy, Unknown
+	Status  map[string]interface{} `json:"status,omitempty"`  // member 클러스터에서 수집한 리소스 status
+}
+
+// Propagation 워크로드 하나의 Karmada 전파 상태
+type Propagation struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Namespace  string            `json:"namespace"`
+	Name       string            `json:"name"`
+	Binding    string            `json:"binding"` // ResourceBinding 이름
+	Policy     *PolicyRef        `json:"policy,omitempty"`
+	Placement  *Placement        `json:"placement,omitempty"`
+	Replicas   int32             `json:"replicas"`   // 분배 대상 replica 수
+	Clusters   []TargetCluster   `json:"clusters"`   // 스케줄 결과
+	Conditions []Condition       `json:"conditions"` // Scheduled, FullyApplied
+	Overrides  []AppliedOverride `json:"overrides"`
+	Status     []WorkStatus      `json:"status"` // Work별 적용 상태
+}
+
+// resourceSelector 정책의 리소스 선택 규칙
+type resourceSelector struct {
+	APIVersion    string                `json:"apiVersion"`
+	Kind          string                `json:"kind"`
+	Namespace     string                `json:"namespace,omitempty"`
+	Name          string                `json:"name,omitempty"`
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// propagationPolicy PropagationPolicy/ClusterPropagationPolicy에서 사용하는 필드
+type propagationPolicy struct {
+	Metadata metav1.ObjectMeta `json:"metadata"`
+	Spec     struct {
+		Placement Placement `json:"placement"`
+		Priority  *int32    `json:"priority,omitempty"`
+	} `json:"spec"`
+}
+
+// overridePolicy OverridePolicy에서 사용하는 필드
+type overridePolicy struct {
+	Metadata metav1.ObjectMeta `json:"metadata"`
+	Spec     struct {
+		ResourceSelectors []resourceSelector `json:"resourceSelectors"`
+		OverrideRules     []struct {
+			TargetCluster *ClusterAffinity `json:"targetCluster,omitempty"`
+			Overriders    overriders       `json:"overriders"`
+		} `json:"overrideRules"`
+	} `json:"spec"`
+}
+
+// overriders OverridePolicy 규칙의 변경 내용
+type overriders struct {
+	Plaintext []struct {
+		Path     string `json:"path"`
+		Operator string `json:"operator"`
+	} `json:"plaintext,omitempty"`
+	ImageOverrider []struct {
+		Component string `json:"component"`
+		Operator  string `json:"operator"`
+		Value     string `json:"value,omitempty"`
+		Predicate *struct {
+			Path string `json:"path"`
+		} `json:"predicate,omitempty"`
+	} `json:"imageOverrider,omitempty"`
+	CommandOverrider     []containerOverrider `json:"commandOverrider,omitempty"`
+	ArgsOverrider        []containerOverrider `json:"argsOverrider,omitempty"`
+	LabelsOverrider      []metadataOverrider  `json:"labelsOverrider,omitempty"`
+	AnnotationsOverrider []metadataOverrider  `json:"annotationsOverrider,omitempty"`
+}
+
+// containerOverrider command/args 변경
+type containerOverrider struct {
+	ContainerName string   `json:"containerName"`
+	Operator      string   `json:"operator"`
+	Value         []string `json:"value,omitempty"`
+}
+
+// metadataOverrider labels/annotations 변경
+type metadataOverrider struct {
+	Operator string            `json:"operator"`
+	Value    map[string]string `json:"value,omitempty"`
+}
+
+// resourceBinding ResourceBinding에서 사용하는 필드
+type resourceBinding struct {
+	Metadata metav1.ObjectMeta `json:"metadata"`
+	Spec     struct {
+		Resource struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+			Namespace  string `json:"namespace"`
+			Name       string `json:"name"`
+		} `json:"resource"`
+		Replicas  int32           `json:"replicas,omitempty"`
+		Clusters  []TargetCluster `json:"clusters,omitempty"`
+		Placement *Placement      `json:"placement,omitempty"` // Karmada 1.7+에서 정책 배치 규칙 사본
+	} `json:"spec"`
+	Status struct {
+		Conditions []Condition `json:"conditions,omitempty"`
+	} `json:"status"`
+}
+
+// work Work에서 사용하는 필드
+type work struct {
+	Metadata metav1.ObjectMeta `json:"metadata"`
+	Spec     struct {
+		Workload struct {
+			Manifests []unstructured.Unstructured `json:"manifests"`
+		} `json:"workload"`
+	} `json:"spec"`
+	Status struct {
+		Conditions       []Condition `json:"conditions,omitempty"`
+		ManifestStatuses []struct {
+			Identifier struct {
+				Kind      string `json:"kind"`
+				Namespace string `json:"namespace"`
+				Name      string `json:"name"`
+			} `json:"identifier"`
+			Status map[string]interface{} `json:"status,omitempty"`
+			Health string                 `json:"health,omitempty"`
+		} `json:"manifestStatuses,omitempty"`
+	} `json:"status"`
+}
+
+// PropagationView Karmada 전파 정책, ResourceBinding, Work를 주기적으로 조회해 워크로드별 전파 상태 제공
+type PropagationView struct {
+	client     *Client
+	namespaces []string
+	interval   time.Duration
+
+	mu           sync.RWMutex
+	propagations []Propagation
+	updatedAt    time.Time
+}
+
+// NewPropagationViewFromEnv 환경변수 기반 전파 상태 조회기 생성
+// KARMADA_NAMESPACES 기본값은 APP_NAMESPACE, tf-monitor ("*"이면 전체 네임스페이스)
+// KARMADA_REFRESH_INTERVAL 기본값은 30s
+func NewPropagationViewFromEnv(client *Client) *PropagationView {
+	appNamespace := os.Getenv("APP_NAMESPACE")
+	if appNamespace == "" {
+		appNamespace = "default"
+	}
+
+	namespaces := splitList(getEnvDefault("KARMADA_NAMESPACES", appNamespace+",tf-monitor"))
+	for _, ns := range namespaces {
+		if ns == "*" {
+			namespaces = []string{metav1.NamespaceAll}
+			break
+		}
+	}
+
+	return &PropagationView{
+		client:       client,
+		namespaces:   namespaces,
+		interval:     getDurationEnv("KARMADA_REFRESH_INTERVAL", 30*time.Second),
+		propagations: []Propagation{},
+	}
+}
+
+// Enabled Karmada API 서버를 조회할 수 있는지
+func (v *PropagationView) Enabled() bool {
+	return v.client.Enabled()
+}
+
+// Run 주기적으로 전파 상태 갱신 (블로킹)
+func (v *PropagationView) Run() {
+	if !v.Enabled() || v.interval <= 0 {
+		log.Printf("[Karmada] Propagation view disabled")
+		return
+	}
+
+	log.Printf("[Karmada] Propagation view started (namespaces: %s, interval: %s)", strings.Join(v.namespaces, ", "), v.interval)
+
+	ticker := time.NewTicker(v.interval)
+	defer ticker.Stop()
+
+	v.refreshAndLog()
+	for range ticker.C {
+		v.refreshAndLog()
+	}
+}
+
+// refreshAndLog 갱신 실패 시 이전 결과 유지
+func (v *PropagationView) refreshAndLog() {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	if err := v.Refresh(ctx); err != nil {
+		log.Printf("Warning: [Karmada] Failed to refresh propagation view: %v", err)
+	}
+}
+
+// Refresh Karmada API 서버에서 정책, ResourceBinding, Work를 조회해 전파 상태 재구성
+func (v *PropagationView) Refresh(ctx context.Context) error {
+	policies := make(map[string]propagationPolicy) // namespace/name
+	overrides := make(map[string][]overridePolicy) // namespace
+	bindings := make([]resourceBinding, 0)
+
+	for _, namespace := range v.namespaces {
+		if err := v.list(ctx, propagationPolicyGVR, namespace, func(item map[string]interface{}) error {
+			var policy propagationPolicy
+			if err := decode(item, &policy); err != nil {
+				return err
+			}
+			policies[policy.Metadata.Namespace+"/"+policy.Metadata.Name] = policy
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if err := v.list(ctx, overridePolicyGVR, namespace, func(item map[string]interface{}) error {
+			var policy overridePolicy
+			if err := decode(item, &policy); err != nil {
+				return err
+			}
+			overrides[policy.Metadata.Namespace] = append(overrides[policy.Metadata.Namespace], policy)
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if err := v.list(ctx, resourceBindingGVR, namespace, func(item map[string]interface{}) error {
+			var binding resourceBinding
+			if err := decode(item, &binding); err != nil {
+				return err
+			}
+			bindings = append(bindings, binding)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	clusterPolicies := make(map[string]propagationPolicy)
+	if err := v.list(ctx, clusterPropagationPolicyGVR, metav1.NamespaceAll, func(item map[string]interface{}) error {
+		var policy propagationPolicy
+		if err := decode(item, &policy); err != nil {
+			return err
+		}
+		clusterPolicies[policy.Metadata.Name] = policy
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// Work는 실행 네임스페이스에 있으므로 전체에서 조회 후 매니페스트로 워크로드 매칭
+	statuses := make(map[string][]WorkStatus)
+	workloadLabels := make(map[string]map[string]string)
+	if err := v.list(ctx, workGVR, metav1.NamespaceAll, func(item map[string]interface{}) error {
+		var w work
+		if err := decode(item, &w); err != nil {
+			return err
+		}
+		if !strings.HasPrefix(w.Metadata.Namespace, executionNamespacePrefix) {
+			return nil
+		}
+		for key, status := range workStatuses(w) {
+			statuses[key] = append(statuses[key], status)
+		}
+		for _, manifest := range w.Spec.Workload.Manifests {
+			key := workloadKey(manifest.GetKind(), manifest.GetNamespace(), manifest.GetName())
+			if _, ok := workloadLabels[key]; !ok {
+				workloadLabels[key] = manifest.GetLabels()
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	propagations := make([]Propagation, 0, len(bindings))
+	for _, binding := range bindings {
+		resource := binding.Spec.Resource
+		key := workloadKey(resource.Kind, resource.Namespace, resource.Name)
+
+		p := Propagation{
+			APIVersion: resource.APIVersion,
+			Kind:       resource.Kind,
+			Namespace:  resource.Namespace,
+			Name:       resource.Name,
+			Binding:    binding.Metadata.Name,
+			Placement:  binding.Spec.Placement,
+			Replicas:   binding.Spec.Replicas,
+			Clusters:   binding.Spec.Clusters,
+			Conditions: binding.Status.Conditions,
+			Overrides:  []AppliedOverride{},
+			Status:     statuses[key],
+		}
+		if p.Clusters == nil {
+			p.Clusters = []TargetCluster{}
+		}
+		if p.Conditions == nil {
+			p.Conditions = []Condition{}
+		}
+		if p.Status == nil {
+			p.Status = []WorkStatus{}
+		}
+		sort.Slice(p.Status, func(i, j int) bool { return p.Status[i].Cluster < p.Status[j].Cluster })
+
+		if ref, policy, ok := bindingPolicy(binding, policies, clusterPolicies); ok {
+			p.Policy = ref
+			placement := policy.Spec.Placement
+			p.Placement = &placement
+		}
+
+		for _, policy := range overrides[resource.Namespace] {
+			if !policy.selects(resource.APIVersion, resource.Kind, resource.Namespace, resource.Name, workloadLabels[key]) {
+				continue
+			}
+			for _, rule := range policy.Spec.OverrideRules {
+				p.Overrides = append(p.Overrides, AppliedOverride{
+					Policy:        policy.Metadata.Namespace + "/" + policy.Metadata.Name,
+					TargetCluster: rule.TargetCluster,
+					Overriders:    rule.Overriders.summary(),
+				})
+			}
+		}
+
+		propagations = append(propagations, p)
+	}
+	sort.Slice(propagations, func(i, j int) bool {
+		a, b := propagations[i], propagations[j]
+		return workloadKey(a.Kind, a.Namespace, a.Name) < workloadKey(b.Kind, b.Namespace, b.Name)
+	})
+
+	v.mu.Lock()
+	v.propagations = propagations
+	v.updatedAt = time.Now()
+	v.mu.Unlock()
+	return nil
+}
+
+// list 리소스 목록 조회 후 항목별 처리
+func (v *PropagationView) list(ctx context.Context, gvr schema.GroupVersionResource, namespace string, fn func(map[string]interface{}) error) error {
+	list, err := v.client.dynamic.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("list %s: %w", gvr.Resource, err)
+	}
+	for _, item := range list.Items {
+		if err := fn(item.Object); err != nil {
+			return fmt.Errorf("decode %s %s/%s: %w", gvr.Resource, item.GetNamespace(), item.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// Refreshed 한 번 이상 갱신되었는지
+func (v *PropagationView) Refreshed() bool {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	return !v.updatedAt.IsZero()
+}
+
+// List 워크로드 전파 상태 목록 (빈 값은 필터하지 않음)
+func (v *PropagationView) List(kind, namespace, name string) []Propagation {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	result := make([]Propagation, 0)
+	for _, p := range v.propagations {
+		if (kind == "" || p.Kind == kind) && (namespace == "" || p.Namespace == namespace) && (name == "" || p.Name == name) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// WorkloadPlacement 트래픽 그래프용 전파 정보 요약 (monitor.PlacementSource 구현)
+func (v *PropagationView) WorkloadPlacement(kind, namespace, name string) (monitor.WorkloadPlacement, bool) {
+	propagations := v.List(kind, namespace, name)
+	if len(propagations) == 0 {
+		return monitor.WorkloadPlacement{}, false
+	}
+	p := propagations[0]
+
+	placement := monitor.WorkloadPlacement{
+		Clusters:     make([]monitor.ScheduledCluster, 0, len(p.Clusters)),
+		Overrides:    make([]string, 0),
+		Scheduled:    conditionTrue(p.Conditions, "Scheduled"),
+		FullyApplied: conditionTrue(p.Conditions, "FullyApplied"),
+	}
+
+	if p.Policy != nil {
+		placement.Policy = p.Policy.Name
+		if p.Policy.Namespace != "" {
+			placement.Policy = p.Policy.Namespace + "/" + p.Policy.Name
+		}
+	}
+	if p.Placement != nil && p.Placement.ReplicaScheduling != nil {
+		rs := p.Placement.ReplicaScheduling
+		placement.ReplicaScheduling = rs.ReplicaSchedulingType
+		if rs.ReplicaSchedulingType == "Divided" && rs.ReplicaDivisionPreference != "" {
+			placement.ReplicaScheduling += "/" + rs.ReplicaDivisionPreference
+		}
+	}
+
+	if p.Placement != nil {
+		placement.EligibleClusters, placement.ExcludedClusters = p.Placement.eligibleClusters()
+		placement.EvictionBlocked = p.Placement.toleratesClusterFailure()
+	}
+
+	for _, cluster := range p.Clusters {
+		scheduled := monitor.ScheduledCluster{Name: cluster.Name, Replicas: cluster.Replicas}
+		for _, status := range p.Status {
+			if status.Cluster == cluster.Name {
+				scheduled.Applied = status.Applied
+				scheduled.Health = status.Health
+			}
+		}
+		placement.Clusters = append(placement.Clusters, scheduled)
+	}
+
+	seen := make(map[string]bool)
+	for _, o := range p.Overrides {
+		if !seen[o.Policy] {
+			seen[o.Policy] = true
+			placement.Overrides = append(placement.Overrides, o.Policy)
+		}
+	}
+	return placement, true
+}
+
+// eligibleClusters clusterAffinity/clusterAffinities가 이름으로 허용/제외한 클러스터
+// 라벨 셀렉터를 쓰거나 이름 제한이 없는 그룹이 있으면 허용 목록은 nil (제한 없음)
+func (p *Placement) eligibleClusters() (eligible, excluded []string) {
+	affinities := make([]ClusterAffinity, 0, len(p.ClusterAffinities)+1)
+	if p.ClusterAffinity != nil {
+		affinities = append(affinities, *p.ClusterAffinity)
+	}
+	for _, named := range p.ClusterAffinities {
+		affinities = append(affinities, named.ClusterAffinity)
+	}
+	if len(affinities) == 0 {
+		return nil, nil
+	}
+
+	restricted := true
+	names := make(map[string]bool)
+	excludes := make(map[string]int)
+	for _, affinity := range affinities {
+		if affinity.LabelSelector != nil || len(affinity.ClusterNames) == 0 {
+			restricted = false
+		}
+		for _, name := range affinity.ClusterNames {
+			names[name] = true
+		}
+		for _, name := range affinity.ExcludeClusters {
+			excludes[name]++
+		}
+	}
+
+	// 모든 그룹에서 제외된 클러스터만 제외로 취급
+	for name, count := range excludes {
+		if count == len(affinities) {
+			excluded = append(excluded, name)
+			delete(names, name)
+		}
+	}
+	sort.Strings(excluded)
+	if !restricted {
+		return nil, excluded
+	}
+
+	eligible = make([]string, 0, len(names))
+	for name := range names {
+		eligible = append(eligible, name)
+	}
+	sort.Strings(eligible)
+	return eligible, excluded
+}
+
+// toleratesClusterFailure 클러스터 장애 taint(NoExecute)를 기한 없이 허용해 장애 시에도 축출되지 않는지
+func (p *Placement) toleratesClusterFailure() bool {
+	for _, toleration := range p.ClusterTolerations {
+		if toleration.Effect != "" && toleration.Effect != "NoExecute" {
+			continue
+		}
+		if toleration.TolerationSeconds != nil {
+			continue
+		}
+		if toleration.Key == "" && toleration.Operator == "Exists" {
+			return true
+		}
+		if toleration.Key == clusterNotReadyTaint || toleration.Key == clusterUnreachableTaint {
+			return true
+		}
+	}
+	return false
+}
+
+// bindingPolicy ResourceBinding 라벨/어노테이션이 가리키는 전파 정책
+func bindingPolicy(binding resourceBinding, policies, clusterPolicies map[string]propagationPolicy) (*PolicyRef, propagationPolicy, bool) {
+	meta := func(key string) string {
+		if value := binding.Metadata.Annotations[key]; value != "" {
+			return value
+		}
+		return binding.Metadata.Labels[key]
+	}
+
+	if name := meta(propagationPolicyNameKey); name != "" {
+		namespace := meta(propagationPolicyNamespaceKey)
+		if namespace == "" {
+			namespace = binding.Metadata.Namespace
+		}
+		if policy, ok := policies[namespace+"/"+name]; ok {
+			return &PolicyRef{Kind: "PropagationPolicy", Namespace: namespace, Name: name, Priority: policy.priority()}, policy, true
+		}
+	}
+	if name := meta(clusterPropagationPolicyNameKey); name != "" {
+		if policy, ok := clusterPolicies[name]; ok {
+			return &PolicyRef{Kind: "ClusterPropagationPolicy", Name: name, Priority: policy.priority()}, policy, true
+		}
+	}
+	return nil, propagationPolicy{}, false
+}
+
+// priority 정책 우선순위 (기본값 0)
+func (p propagationPolicy) priority() int32 {
+	if p.Spec.Priority == nil {
+		return 0
+	}
+	return *p.Spec.Priority
+}
+
+// selects OverridePolicy가 워크로드를 선택하는지
+// labelSelector 선택자는 Work 매니페스트의 라벨로 판단 (라벨을 모르면 선택하지 않음)
+func (p overridePolicy) selects(apiVersion, kind, namespace, name string, workloadLabels map[string]string) bool {
+	for _, rs := range p.Spec.ResourceSelectors {
+		if rs.APIVersion != apiVersion || rs.Kind != kind {
+			continue
+		}
+		if rs.Namespace != "" && rs.Namespace != namespace {
+			continue
+		}
+		if rs.Name != "" {
+			if rs.Name == name {
+				return true
+			}
+			continue
+		}
+		if rs.LabelSelector == nil {
+			return true
+		}
+		selector, err := metav1.LabelSelectorAsSelector(rs.LabelSelector)
+		if err != nil || workloadLabels == nil {
+			continue
+		}
+		if selector.Matches(labels.Set(workloadLabels)) {
+			return true
+		}
+	}
+	return false
+}
+
+// summary 변경 내용을 사람이 읽을 수 있는 한 줄씩으로 요약
+func (o overriders) summary() []string {
+	lines := make([]string, 0)
+	for _, p := range o.Plaintext {
+		lines = append(lines, fmt.Sprintf("plaintext: %s %s", p.Operator, p.Path))
+	}
+	for _, img := range o.ImageOverrider {
+		line := fmt.Sprintf("image: %s %s", img.Operator, img.Component)
+		if img.Value != "" {
+			line += "=" + img.Value
+		}
+		if img.Predicate != nil && img.Predicate.Path != "" {
+			line += " (" + img.Predicate.Path + ")"
+		}
+		lines = append(lines, line)
+	}
+	for _, c := range o.CommandOverrider {
+		lines = append(lines, fmt.Sprintf("command[%s]: %s %s", c.ContainerName, c.Operator, strings.Join(c.Value, " ")))
+	}
+	for _, c := range o.ArgsOverrider {
+		lines = append(lines, fmt.Sprintf("args[%s]: %s %s", c.ContainerName, c.Operator, strings.Join(c.Value, " ")))
+	}
+	for _, m := range o.LabelsOverrider {
+		lines = append(lines, fmt.Sprintf("labels: %s %s", m.Operator, formatMap(m.Value)))
+	}
+	for _, m := range o.AnnotationsOverrider {
+		lines = append(lines, fmt.Sprintf("annotations: %s %s", m.Operator, formatMap(m.Value)))
+	}
+	return lines
+}
+
+// workStatuses Work에 포함된 워크로드별 적용 상태
+func workStatuses(w work) map[string]WorkStatus {
+	cluster := strings.TrimPrefix(w.Metadata.Namespace, executionNamespacePrefix)
+
+	base := WorkStatus{Cluster: cluster, Work: w.Metadata.Name}
+	for _, cond := range w.Status.Conditions {
+		if cond.Type == "Applied" {
+			base.Applied = cond.Status == string(metav1.ConditionTrue)
+			base.Reason = cond.Reason
+			base.Message = cond.Message
+		}
+	}
+
+	result := make(map[string]WorkStatus)
+	for _, manifest := range w.Spec.Workload.Manifests {
+		result[workloadKey(manifest.GetKind(), manifest.GetNamespace(), manifest.GetName())] = base
+	}
+	for _, ms := range w.Status.ManifestStatuses {
+		key := workloadKey(ms.Identifier.Kind, ms.Identifier.Namespace, ms.Identifier.Name)
+		status := base
+		status.Health = ms.Health
+		status.Status = ms.Status
+		result[key] = status
+	}
+	return result
+}
+
+// conditionTrue 조건이 True인지
+func conditionTrue(conditions []Condition, conditionType string) bool {
+	for _, cond := range conditions {
+		if cond.Type == conditionType {
+			return cond.Status == string(metav1.ConditionTrue)
+		}
+	}
+	return false
+}
+
+// decode unstructured 객체를 필요한 필드만 정의한 구조체로 변환
+func decode(object map[string]interface{}, v interface{}) error {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// workloadKey 워크로드 식별 키
+func workloadKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// formatMap key=value 목록 (키 순서 고정)
+func formatMap(m map[string]string) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+m[k])
+	}
+	return strings.Join(pairs, ",")
+}
@@ -18,38 +18,90 @@ import (
 
 // NodeInfo 노드 정보 구조체
 type NodeInfo struct {
-	Name             string `json:"name"`
-	Status           string `json:"status"`
-	Roles            string `json:"roles"`
-	Age              string `json:"age"`
-	Version          string `json:"version"`
-	InternalIP       string `json:"internalIP"`
-	OSImage          string `json:"osImage"`
-	KernelVersion    string `json:"kernelVersion"`
-	ContainerRuntime string `json:"containerRuntime"`
+	Name             string             `json:"name"`
+	Status           string             `json:"status"`
+	Roles            string             `json:"roles"`
+	Age              string             `json:"age"`
+	Version          string             `json:"version"`
+	InternalIP       string             `json:"internalIP"`
+	OSImage          string             `json:"osImage"`
+	KernelVersion    string             `json:"kernelVersion"`
+	ContainerRuntime string             `json:"containerRuntime"`
+	Unschedulable    bool               `json:"unschedulable"` // cordon 여부
+	Labels           map[string]string  `json:"labels,omitempty"`
+	Zone             string             `json:"zone,omitempty"`   // topology.kubernetes.io/zone
+	Region           string             `json:"region,omitempty"` // topology.kubernetes.io/region
+	Conditions       []NodeCondition    `json:"conditions"`       // 모든 노드 조건 (Ready가 맨 앞)
+	Taints           []NodeTaint        `json:"taints"`
+	NotReady         *NodeNotReadyCause `json:"notReady,omitempty"` // NotReady 원인 (Ready면 없음)
+	Capacity         NodeResources      `json:"capacity"`
+	Allocatable      NodeResources      `json:"allocatable"`
+	Requests         NodeResources      `json:"requests"`        // 노드에서 실행 중인 Pod 요청 합계 (pods는 Pod 수)
+	Limits           NodeResources      `json:"limits"`          // 노드에서 실행 중인 Pod 제한 합계
+	Usage            *NodeUsage         `json:"usage,omitempty"` // metrics.k8s.io 실측값 (metrics-server가 없으면 nil)
 }
 
 // PodInfo Pod 정보 구조체
 type PodInfo struct {
-	Name     string `json:"name"`
-	Ready    string `json:"ready"`
-	Status   string `json:"status"`
-	Restarts int32  `json:"restarts"`
-	Age      string `json:"age"`
-	IP       string `json:"ip"`
-	Node     string `json:"node"`
+	Name       string            `json:"name"`
+	Namespace  string            `json:"namespace"`
+	Ready      string            `json:"ready"`
+	Status     string            `json:"status"`            // Pod phase
+	Reason     string            `json:"reason,omitempty"`  // kubectl STATUS 열과 같은 대표 원인 (CrashLoopBackOff, OOMKilled, Unschedulable ...)
+	Message    string            `json:"message,omitempty"` // 스케줄러 unschedulable 메시지 등 원인 설명
+	Restarts   int32             `json:"restarts"`
+	Age        string            `json:"age"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	IP         string            `json:"ip"`
+	Node       string            `json:"node"`
+	Labels     map[string]string `json:"labels,omitempty"`
+	Containers []ContainerInfo   `json:"containers"`
+}
+
+// ContainerInfo 컨테이너 상태
+type ContainerInfo struct {
+	Name            string           `json:"name"`
+	Init            bool             `json:"init,omitempty"` // init 컨테이너 여부
+	Ready           bool             `json:"ready"`
+	State           string           `json:"state"`            // waiting, running, terminated
+	Reason          string           `json:"reason,omitempty"` // waiting/terminated 원인 (CrashLoopBackOff, OOMKilled ...)
+	Message         string           `json:"message,omitempty"`
+	ExitCode        *int32           `json:"exitCode,omitempty"` // terminated 상태의 종료 코드
+	FinishedAt      *time.Time       `json:"finishedAt,omitempty"`
+	Restarts        int32            `json:"restarts"`
+	StartedAt       *time.Time       `json:"startedAt,omitempty"`
+	LastTermination *TerminationInfo `json:"lastTermination,omitempty"` // 직전 종료 정보
+}
+
+// TerminationInfo 컨테이너 종료 정보
+type TerminationInfo struct {
+	Reason     string    `json:"reason"`
+	ExitCode   int32     `json:"exitCode"`
+	Message    string    `json:"message,omitempty"`
+	FinishedAt time.Time `json:"finishedAt"`
+}
+
+// ProbeHealth 합성 프로브 결과 요약
+type ProbeHealth struct {
+	Total          int      `json:"total"`          // 프로브 대상 수
+	Failing        int      `json:"failing"`        // 연속 실패 임계값을 넘은 대상 수
+	FailingTargets []string `json:"failingTargets"` // 실패 중인 대상 URL
+	AvgLatencyMs   int64    `json:"avgLatencyMs"`   // 성공한 대상의 평균 응답 시간
 }
 
 // ClusterInfo 클러스터 정보 구조체
 type ClusterInfo struct {
-	ID       string     `json:"id"`
-	Name     string     `json:"name"`
-	Status   string     `json:"status"`   // ready, failure
-	Pods     int        `json:"pods"`     // Pod 개수
-	Region   string     `json:"region"`   // 리전
-	Sessions int        `json:"sessions"` // 활성 세션 수 (계산값)
-	Nodes    []NodeInfo `json:"nodes"`    // 노드 상세 정보
-	PodList  []PodInfo  `json:"podList"`  // Pod 상세 정보
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Status      string              `json:"status"`                // ready, degraded, failure
+	Reason      string              `json:"reason,omitempty"`      // degraded/failure 원인
+	Pods        int                 `json:"pods"`                  // Pod 개수
+	Region      string              `json:"region"`                // 리전
+	Sessions    int                 `json:"sessions"`              // 활성 세션 수 (계산값)
+	Nodes       []NodeInfo          `json:"nodes"`                 // 노드 상세 정보
+	PodList     []PodInfo           `json:"podList"`               // Pod 상세 정보
+	Probes      *ProbeHealth        `json:"probes,omitempty"`      // 합성 프로브 결과
+	Utilization *ClusterUtilization `json:"utilization,omitempty"` // Ready 노드 기준 리소스 사용률
 }
 
 // ClusterMonitor 클러스터 모니터링
@@ -0,0 +1,662 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// 장애 대비 판정
+const (
+	ReadinessReady    = "ready"     // 해당 클러스터가 빠져도 나머지 클러스터가 트래픽을 받을 수 있음
+	ReadinessAtRisk   = "at-risk"   // 받을 수는 있지만 수동 조치가 필요하거나 확인하지 못한 항목이 있음
+	ReadinessNotReady = "not-ready" // 서비스 중단 또는 용량 부족이 예상됨
+)
+
+// ReadinessIssue 준비 상태 점검에서 발견한 문제
+type ReadinessIssue struct {
+	Severity string `json:"severity"`          // warning, critical
+	Check    string `json:"check"`             // presence, health, capacity, hpa, pdb, placement, requests, inventory
+	Cluster  string `json:"cluster,omitempty"` // 문제가 있는 클러스터
+	Message  string `json:"message"`
+}
+
+// HPASummary 워크로드를 대상으로 하는 HorizontalPodAutoscaler
+type HPASummary struct {
+	Name            string `json:"name"`
+	MinReplicas     int32  `json:"minReplicas"`
+	MaxReplicas     int32  `json:"maxReplicas"`
+	CurrentReplicas int32  `json:"currentReplicas"`
+}
+
+// PDBSummary 워크로드 Pod를 선택하는 PodDisruptionBudget
+type PDBSummary struct {
+	Name               string `json:"name"`
+	MinAvailable       string `json:"minAvailable,omitempty"`
+	MaxUnavailable     string `json:"maxUnavailable,omitempty"`
+	DisruptionsAllowed int32  `json:"disruptionsAllowed"`
+}
+
+// WorkloadClusterReadiness 클러스터 하나에서의 워크로드 상태
+type WorkloadClusterReadiness struct {
+	Cluster        string       `json:"cluster"`
+	Inspected      bool         `json:"inspected"` // 조회 성공 여부 (false면 나머지 필드는 의미 없음)
+	Eligible       bool         `json:"eligible"`  // Karmada placement가 이 클러스터를 허용하는지
+	Present        bool         `json:"present"`
+	Replicas       int32        `json:"replicas"`
+	ReadyReplicas  int32        `json:"readyReplicas"`
+	Healthy        bool         `json:"healthy"`
+	PodCPUMillis   int64        `json:"podCpuMillis"`   // Pod 하나의 CPU 요청
+	PodMemoryBytes int64        `json:"podMemoryBytes"` // Pod 하나의 메모리 요청
+	HPA            *HPASummary  `json:"hpa,omitempty"`
+	PDBs           []PDBSummary `json:"pdbs,omitempty"`
+}
+
+// WorkloadReadiness 워크로드 하나의 클러스터별 상태와 상시 문제
+type WorkloadReadiness struct {
+	Kind      string                     `json:"kind"` // Deployment, StatefulSet
+	Namespace string                     `json:"namespace"`
+	Name      string                     `json:"name"`
+	Placement *WorkloadPlacement         `json:"placement,omitempty"`
+	Clusters  []WorkloadClusterReadiness `json:"clusters"`
+	Issues    []ReadinessIssue           `json:"issues"`
+}
+
+// ClusterCapacity 클러스터의 스케줄 가능한 노드 기준 리소스 여유
+type ClusterCapacity struct {
+	Cluster                string `json:"cluster"`
+	Inspected              bool   `json:"inspected"`
+	Nodes                  int    `json:"nodes"` // Ready이면서 cordon되지 않은 노드
+	AllocatableCPUMillis   int64  `json:"allocatableCpuMillis"`
+	AllocatableMemoryBytes int64  `json:"allocatableMemoryBytes"`
+	RequestedCPUMillis     int64  `json:"requestedCpuMillis"`
+	RequestedMemoryBytes   int64  `json:"requestedMemoryBytes"`
+	HeadroomCPUMillis      int64  `json:"headroomCpuMillis"`
+	HeadroomMemoryBytes    int64  `json:"headroomMemoryBytes"`
+}
+
+// SurvivorLoad 장애 시 남은 클러스터가 추가로 받아야 하는 요청량
+type SurvivorLoad struct {
+	Cluster             string `json:"cluster"`
+	ExtraReplicas       int32  `json:"extraReplicas"`
+	ExtraCPUMillis      int64  `json:"extraCpuMillis"`
+	ExtraMemoryBytes    int64  `json:"extraMemoryBytes"`
+	HeadroomCPUMillis   int64  `json:"headroomCpuMillis"`
+	HeadroomMemoryBytes int64  `json:"headroomMemoryBytes"`
+	Fits                bool   `json:"fits"`
+}
+
+// WorkloadFailover 클러스터 장애 시 워크로드 하나의 판정
+type WorkloadFailover struct {
+	Kind          string           `json:"kind"`
+	Namespace     string           `json:"namespace"`
+	Name          string           `json:"name"`
+	LostReplicas  int32            `json:"lostReplicas"`
+	ExtraReplicas map[string]int32 `json:"extraReplicas"` // 남은 클러스터 ID -> 추가로 필요한 replica
+	Verdict       string           `json:"verdict"`
+	Issues        []ReadinessIssue `json:"issues"`
+}
+
+// FailoverScenario "이 클러스터가 장애나면" 판정
+type FailoverScenario struct {
+	FailedCluster string             `json:"failedCluster"`
+	Verdict       string             `json:"verdict"`
+	Survivors     []SurvivorLoad     `json:"survivors"`
+	Workloads     []WorkloadFailover `json:"workloads"`
+	Issues        []ReadinessIssue   `json:"issues"` // 워크로드와 무관한 문제 (용량 부족 등)
+}
+
+// FailoverReadinessReport 장애 대비 준비 상태 보고
+type FailoverReadinessReport struct {
+	Time      time.Time           `json:"time"`
+	Clusters  []string            `json:"clusters"`
+	Verdict   string              `json:"verdict"` // 모든 시나리오 중 가장 나쁜 판정
+	Capacity  []ClusterCapacity   `json:"capacity"`
+	Workloads []WorkloadReadiness `json:"workloads"`
+	Scenarios []FailoverScenario  `json:"scenarios"`
+	Errors    []string            `json:"errors,omitempty"`
+}
+
+// FailoverReadiness member 클러스터 하나가 빠졌을 때 나머지가 트래픽을 받을 수 있는지 평가
+type FailoverReadiness struct {
+	clients    map[string]kubernetes.Interface // 클러스터 ID별 클라이언트
+	namespaces []string
+	placement  func(kind, namespace, name string) *WorkloadPlacement
+	cacheTTL   time.Duration // 이 기간 안의 요청은 마지막 평가 결과 사용
+
+	mu     sync.Mutex // Evaluate 동시 실행 방지
+	cached *FailoverReadinessReport
+}
+
+// NewFailoverReadinessFromEnv 환경변수 기반 장애 대비 평가기 생성
+// FAILOVER_READINESS_NAMESPACES 기본값은 APP_NAMESPACE, tf-monitor
+// FAILOVER_READINESS_CACHE_TTL(기본값 30s) 동안은 모든 클러스터를 다시 조회하지 않음
+func NewFailoverReadinessFromEnv(mcm *MultiClusterMonitor) *FailoverReadiness {
+	clients := make(map[string]kubernetes.Interface, len(mcm.memberClusters))
+	for contextName, clientset := range mcm.memberClusters {
+		if id, ok := memberClusterIDs[contextName]; ok {
+			clients[id] = clientset
+		}
+	}
+
+	appNamespace := os.Getenv("APP_NAMESPACE")
+	if appNamespace == "" {
+		appNamespace = "default"
+	}
+
+	return &FailoverReadiness{
+		clients:    clients,
+		namespaces: dedupStrings(splitList(getEnvDefault("FAILOVER_READINESS_NAMESPACES", appNamespace+",tf-monitor"))),
+		placement:  mcm.trafficMonitor.placement,
+		cacheTTL:   getDurationEnv("FAILOVER_READINESS_CACHE_TTL", 30*time.Second),
+	}
+}
+
+// readinessWorkload 평가용으로 모은 워크로드 상태
+type readinessWorkload struct {
+	kind      string
+	namespace string
+	name      string
+	state     WorkloadClusterReadiness
+	labels    map[string]string // Pod 템플릿 라벨
+}
+
+// clusterInventory 클러스터 하나에서 조회한 결과
+type clusterInventory struct {
+	capacity  ClusterCapacity
+	listed    map[string]bool               // 워크로드 조회에 성공한 네임스페이스
+	workloads map[string]*readinessWorkload // kind/namespace/name
+}
+
+// Evaluate 워크로드별 상태와 클러스터별 장애 시나리오 평가 결과 (cacheTTL 안이면 마지막 결과의 복사본)
+// 동시 요청은 진행 중인 평가를 기다렸다가 같은 결과를 사용
+func (f *FailoverReadiness) Evaluate(ctx context.Context) *FailoverReadinessReport {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.cached == nil || time.Since(f.cached.Time) >= f.cacheTTL {
+		// 요청이 끊겨도 다른 요청이 쓸 결과이므로 클러스터별 타임아웃까지 조회
+		f.cached = f.evaluate(context.WithoutCancel(ctx))
+	}
+	return f.cached.clone()
+}
+
+// clone 호출자가 목록을 걸러낼 수 있도록 시나리오 목록까지 복사
+func (r *FailoverReadinessReport) clone() *FailoverReadinessReport {
+	out := *r
+	out.Scenarios = append([]FailoverScenario(nil), r.Scenarios...)
+	return &out
+}
+
+// evaluate 모든 클러스터를 조회해 평가
+func (f *FailoverReadiness) evaluate(ctx context.Context) *FailoverReadinessReport {
+	report := &FailoverReadinessReport{
+		Time:      time.Now(),
+		Clusters:  make([]string, 0, len(f.clients)),
+		Capacity:  []ClusterCapacity{},
+		Workloads: []WorkloadReadiness{},
+		Scenarios: []FailoverScenario{},
+	}
+	for clusterID := range f.clients {
+		report.Clusters = append(report.Clusters, clusterID)
+	}
+	sort.Strings(report.Clusters)
+
+	inventories := make(map[string]*clusterInventory, len(report.Clusters))
+	keys := make(map[string]bool)
+	for _, clusterID := range report.Clusters {
+		inventory, errs := f.inventory(ctx, clusterID)
+		inventories[clusterID] = inventory
+		report.Capacity = append(report.Capacity, inventory.capacity)
+		report.Errors = append(report.Errors, errs...)
+		for key := range inventory.workloads {
+			keys[key] = true
+		}
+	}
+	sort.Strings(report.Errors)
+
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	for _, key := range sortedKeys {
+		kind, rest, _ := strings.Cut(key, "/")
+		namespace, name, _ := strings.Cut(rest, "/")
+
+		workload := WorkloadReadiness{
+			Kind:      kind,
+			Namespace: namespace,
+			Name:      name,
+			Placement: f.placement(kind, namespace, name),
+			Clusters:  make([]WorkloadClusterReadiness, 0, len(report.Clusters)),
+		}
+		for _, clusterID := range report.Clusters {
+			inventory := inventories[clusterID]
+			state := WorkloadClusterReadiness{Cluster: clusterID}
+			if obj, ok := inventory.workloads[key]; ok {
+				state = obj.state
+			} else {
+				state.Inspected = inventory.listed[namespace]
+			}
+			state.Eligible = eligibleFor(workload.Placement, clusterID)
+			workload.Clusters = append(workload.Clusters, state)
+		}
+		workload.Issues = workloadIssues(workload)
+		report.Workloads = append(report.Workloads, workload)
+	}
+
+	report.Verdict = ReadinessReady
+	for _, clusterID := range report.Clusters {
+		unlisted := make([]string, 0)
+		for _, namespace := range f.namespaces {
+			if !inventories[clusterID].listed[namespace] {
+				unlisted = append(unlisted, namespace)
+			}
+		}
+		scenario := failoverScenario(clusterID, report.Workloads, report.Capacity, unlisted)
+		report.Verdict = worseVerdict(report.Verdict, scenario.Verdict)
+		report.Scenarios = append(report.Scenarios, scenario)
+	}
+	if len(report.Clusters) < 2 {
+		report.Verdict = ReadinessNotReady
+	}
+	return report
+}
+
+// inventory 클러스터의 노드 여유, 워크로드, HPA, PDB 조회
+func (f *FailoverReadiness) inventory(ctx context.Context, clusterID string) (*clusterInventory, []string) {
+	client := f.clients[clusterID]
+	inventory := &clusterInventory{
+		capacity:  ClusterCapacity{Cluster: clusterID},
+		listed:    make(map[string]bool),
+		workloads: make(map[string]*readinessWorkload),
+	}
+	errs := make([]string, 0)
+
+	listCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	if err := inventory.loadCapacity(listCtx, client); err != nil {
+		errs = append(errs, fmt.Sprintf("%s: %v", clusterID, err))
+	}
+
+	for _, namespace := range f.namespaces {
+		workloads, err := listReadinessWorkloads(listCtx, client, clusterID, namespace)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", clusterID, err))
+			continue
+		}
+		inventory.listed[namespace] = true
+
+		// HPA, PDB는 조회 실패해도 워크로드 상태는 유지
+		hpas, err := client.AutoscalingV2().HorizontalPodAutoscalers(namespace).List(listCtx, metav1.ListOptions{})
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: list HorizontalPodAutoscaler in %s: %v", clusterID, namespace, err))
+		}
+		pdbs, err := client.PolicyV1().PodDisruptionBudgets(namespace).List(listCtx, metav1.ListOptions{})
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: list PodDisruptionBudget in %s: %v", clusterID, namespace, err))
+		}
+
+		for _, workload := range workloads {
+			if hpas != nil {
+				for _, hpa := range hpas.Items {
+					if hpa.Spec.ScaleTargetRef.Kind != workload.kind || hpa.Spec.ScaleTargetRef.Name != workload.name {
+						continue
+					}
+					workload.state.HPA = &HPASummary{
+						Name:            hpa.Name,
+						MinReplicas:     1,
+						MaxReplicas:     hpa.Spec.MaxReplicas,
+						CurrentReplicas: hpa.Status.CurrentReplicas,
+					}
+					if hpa.Spec.MinReplicas != nil {
+						workload.state.HPA.MinReplicas = *hpa.Spec.MinReplicas
+					}
+				}
+			}
+			if pdbs != nil {
+				for _, pdb := range pdbs.Items {
+					selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+					if err != nil || selector.Empty() || !selector.Matches(labels.Set(workload.labels)) {
+						continue
+					}
+					summary := PDBSummary{Name: pdb.Name, DisruptionsAllowed: pdb.Status.DisruptionsAllowed}
+					if pdb.Spec.MinAvailable != nil {
+						summary.MinAvailable = pdb.Spec.MinAvailable.String()
+					}
+					if pdb.Spec.MaxUnavailable != nil {
+						summary.MaxUnavailable = pdb.Spec.MaxUnavailable.String()
+					}
+					workload.state.PDBs = append(workload.state.PDBs, summary)
+				}
+			}
+			inventory.workloads[workload.kind+"/"+workload.namespace+"/"+workload.name] = workload
+		}
+	}
+	return inventory, errs
+}
+
+// loadCapacity Ready이고 cordon되지 않은 노드의 allocatable과 실행 중인 Pod 요청 합계
+func (inventory *clusterInventory) loadCapacity(ctx context.Context, client kubernetes.Interface) error {
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("list nodes: %w", err)
+	}
+	pods, err := client.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: "status.phase!=Succeeded,status.phase!=Failed",
+	})
+	if err != nil {
+		return fmt.Errorf("list pods: %w", err)
+	}
+
+	capacity := &inventory.capacity
+	schedulable := make(map[string]bool)
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable || !nodeReady(node) {
+			continue
+		}
+		schedulable[node.Name] = true
+		capacity.Nodes++
+		capacity.AllocatableCPUMillis += node.Status.Allocatable.Cpu().MilliValue()
+		capacity.AllocatableMemoryBytes += node.Status.Allocatable.Memory().Value()
+	}
+	for _, pod := range pods.Items {
+		if !schedulable[pod.Spec.NodeName] {
+			continue
+		}
+		requests := podResources(pod.Spec, false)
+		capacity.RequestedCPUMillis += requests.CPUMillis
+		capacity.RequestedMemoryBytes += requests.MemoryBytes
+	}
+	capacity.HeadroomCPUMillis = capacity.AllocatableCPUMillis - capacity.RequestedCPUMillis
+	capacity.HeadroomMemoryBytes = capacity.AllocatableMemoryBytes - capacity.RequestedMemoryBytes
+	capacity.Inspected = true
+	return nil
+}
+
+// listReadinessWorkloads 네임스페이스의 Deployment, StatefulSet 상태
+func listReadinessWorkloads(ctx context.Context, client kubernetes.Interface, clusterID, namespace string) ([]*readinessWorkload, error) {
+	result := make([]*readinessWorkload, 0)
+	add := func(kind string, meta metav1.ObjectMeta, specReplicas *int32, readyReplicas int32, template corev1.PodTemplateSpec) {
+		replicas := int32(1)
+		if specReplicas != nil {
+			replicas = *specReplicas
+		}
+		requests := podResources(template.Spec, false)
+		result = append(result, &readinessWorkload{
+			kind:      kind,
+			namespace: meta.Namespace,
+			name:      meta.Name,
+			labels:    template.Labels,
+			state: WorkloadClusterReadiness{
+				Cluster:        clusterID,
+				Inspected:      true,
+				Present:        true,
+				Replicas:       replicas,
+				ReadyReplicas:  readyReplicas,
+				Healthy:        replicas > 0 && readyReplicas >= replicas,
+				PodCPUMillis:   requests.CPUMillis,
+				PodMemoryBytes: requests.MemoryBytes,
+			},
+		})
+	}
+
+	deployments, err := client.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list Deployment in %s: %w", namespace, err)
+	}
+	for _, d := range deployments.Items {
+		add("Deployment", d.ObjectMeta, d.Spec.Replicas, d.Status.ReadyReplicas, d.Spec.Template)
+	}
+
+	statefulSets, err := client.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list StatefulSet in %s: %w", namespace, err)
+	}
+	for _, s := range statefulSets.Items {
+		add("StatefulSet", s.ObjectMeta, s.Spec.Replicas, s.Status.ReadyReplicas, s.Spec.Template)
+	}
+	return result, nil
+}
+
+// workloadIssues 장애 시나리오와 무관하게 항상 확인하는 문제
+func workloadIssues(workload WorkloadReadiness) []ReadinessIssue {
+	issues := make([]ReadinessIssue, 0)
+	for _, state := range workload.Clusters {
+		switch {
+		case !state.Eligible || !state.Inspected:
+			// 배치 대상이 아니거나 조회하지 못한 클러스터는 판단하지 않음
+		case !state.Present && !dividedPlacement(workload.Placement):
+			issues = append(issues, ReadinessIssue{Severity: "critical", Check: "presence", Cluster: state.Cluster,
+				Message: fmt.Sprintf("%s %s/%s is not deployed", workload.Kind, workload.Namespace, workload.Name)})
+		case state.Present && state.Replicas > 0 && !state.Healthy:
+			issues = append(issues, ReadinessIssue{Severity: "critical", Check: "health", Cluster: state.Cluster,
+				Message: fmt.Sprintf("%d/%d replicas ready", state.ReadyReplicas, state.Replicas)})
+		}
+
+		if state.Present && state.PodCPUMillis == 0 && state.PodMemoryBytes == 0 {
+			issues = append(issues, ReadinessIssue{Severity: "warning", Check: "requests", Cluster: state.Cluster,
+				Message: "no resource requests, capacity check cannot account for extra replicas"})
+		}
+		for _, pdb := range state.PDBs {
+			if pdb.DisruptionsAllowed == 0 {
+				issues = append(issues, ReadinessIssue{Severity: "warning", Check: "pdb", Cluster: state.Cluster,
+					Message: fmt.Sprintf("PodDisruptionBudget %s allows no disruptions, node drains will block", pdb.Name)})
+			}
+		}
+	}
+
+	if p := workload.Placement; p != nil {
+		if len(p.EligibleClusters) == 1 {
+			issues = append(issues, ReadinessIssue{Severity: "critical", Check: "placement",
+				Message: fmt.Sprintf("placement %s only allows cluster %s", p.Policy, p.EligibleClusters[0])})
+		}
+		if p.EvictionBlocked && dividedPlacement(p) {
+			issues = append(issues, ReadinessIssue{Severity: "critical", Check: "placement",
+				Message: fmt.Sprintf("placement %s tolerates cluster failure taints, replicas will not be rescheduled", p.Policy)})
+		}
+	}
+	return issues
+}
+
+// failoverScenario failed 클러스터가 빠졌을 때 잃는 replica를 남은 클러스터가 받을 수 있는지 판정
+// unlisted는 failed 클러스터에서 워크로드를 조회하지 못한 네임스페이스 (잃는 replica를 알 수 없어 at-risk)
+func failoverScenario(failed string, workloads []WorkloadReadiness, capacity []ClusterCapacity, unlisted []string) FailoverScenario {
+	scenario := FailoverScenario{
+		FailedCluster: failed,
+		Verdict:       ReadinessReady,
+		Survivors:     make([]SurvivorLoad, 0, len(capacity)),
+		Workloads:     make([]WorkloadFailover, 0),
+		Issues:        make([]ReadinessIssue, 0),
+	}
+	loads := make(map[string]*SurvivorLoad)
+	for _, c := range capacity {
+		if c.Cluster == failed {
+			continue
+		}
+		scenario.Survivors = append(scenario.Survivors, SurvivorLoad{
+			Cluster:             c.Cluster,
+			HeadroomCPUMillis:   c.HeadroomCPUMillis,
+			HeadroomMemoryBytes: c.HeadroomMemoryBytes,
+		})
+	}
+	for i := range scenario.Survivors {
+		loads[scenario.Survivors[i].Cluster] = &scenario.Survivors[i]
+	}
+
+	for _, workload := range workloads {
+		var lost *WorkloadClusterReadiness
+		survivors := make([]WorkloadClusterReadiness, 0, len(workload.Clusters))
+		for i, state := range workload.Clusters {
+			if state.Cluster == failed {
+				lost = &workload.Clusters[i]
+				continue
+			}
+			// Divided는 Karmada가 허용된 클러스터에 새로 배치, 그 외는 이미 실행 중인 클러스터만 트래픽을 받음
+			if state.Eligible && state.Inspected && (state.Present || dividedPlacement(workload.Placement)) {
+				survivors = append(survivors, state)
+			}
+		}
+		if lost == nil || !lost.Present || lost.Replicas == 0 {
+			continue
+		}
+
+		result := WorkloadFailover{
+			Kind:          workload.Kind,
+			Namespace:     workload.Namespace,
+			Name:          workload.Name,
+			LostReplicas:  lost.Replicas,
+			ExtraReplicas: make(map[string]int32),
+			Issues:        make([]ReadinessIssue, 0),
+		}
+		issue := func(severity, check, cluster, format string, args ...interface{}) {
+			result.Issues = append(result.Issues, ReadinessIssue{Severity: severity, Check: check, Cluster: cluster, Message: fmt.Sprintf(format, args...)})
+		}
+
+		if len(survivors) == 0 {
+			issue("critical", "presence", "", "no other eligible cluster runs %s %s/%s", workload.Kind, workload.Namespace, workload.Name)
+		}
+		if p := workload.Placement; p != nil && p.EvictionBlocked && dividedPlacement(p) {
+			issue("critical", "placement", failed, "placement %s keeps replicas on %s while it is down", p.Policy, failed)
+		}
+
+		for i, state := range survivors {
+			// 잃은 replica를 남은 클러스터에 고르게 분배 (나머지는 앞 클러스터부터)
+			extra := lost.Replicas / int32(len(survivors))
+			if int32(i) < lost.Replicas%int32(len(survivors)) {
+				extra++
+			}
+			result.ExtraReplicas[state.Cluster] = extra
+
+			if state.Present && !state.Healthy && state.Replicas > 0 {
+				issue("critical", "health", state.Cluster, "only %d/%d replicas ready", state.ReadyReplicas, state.Replicas)
+			}
+
+			podCPU, podMemory := state.PodCPUMillis, state.PodMemoryBytes
+			if !state.Present {
+				podCPU, podMemory = lost.PodCPUMillis, lost.PodMemoryBytes
+			}
+			if load := loads[state.Cluster]; load != nil {
+				load.ExtraReplicas += extra
+				load.ExtraCPUMillis += int64(extra) * podCPU
+				load.ExtraMemoryBytes += int64(extra) * podMemory
+			}
+
+			needed := state.Replicas + extra
+			switch {
+			case state.HPA != nil && state.HPA.MaxReplicas < needed:
+				issue("warning", "hpa", state.Cluster, "HorizontalPodAutoscaler %s maxReplicas %d is below the %d replicas needed", state.HPA.Name, state.HPA.MaxReplicas, needed)
+			case state.HPA == nil && !dividedPlacement(workload.Placement):
+				issue("warning", "hpa", state.Cluster, "no HorizontalPodAutoscaler, %d replicas will not scale up to %d", state.Replicas, needed)
+			}
+		}
+
+		result.Verdict = verdictOf(result.Issues)
+		scenario.Verdict = worseVerdict(scenario.Verdict, result.Verdict)
+		scenario.Workloads = append(scenario.Workloads, result)
+	}
+
+	inspected := make(map[string]bool)
+	for _, c := range capacity {
+		inspected[c.Cluster] = c.Inspected
+	}
+	// 장애 대상 클러스터를 조회하지 못하면 잃는 replica가 없는 것처럼 보이므로 ready로 판정하지 않음
+	if !inspected[failed] {
+		scenario.Issues = append(scenario.Issues, ReadinessIssue{Severity: "warning", Check: "inventory", Cluster: failed,
+			Message: "cluster could not be inspected, lost replicas unknown"})
+	}
+	if len(unlisted) > 0 {
+		scenario.Issues = append(scenario.Issues, ReadinessIssue{Severity: "warning", Check: "inventory", Cluster: failed,
+			Message: fmt.Sprintf("workloads in %s could not be listed, lost replicas unknown", strings.Join(unlisted, ", "))})
+	}
+	for i := range scenario.Survivors {
+		load := &scenario.Survivors[i]
+		if !inspected[load.Cluster] {
+			scenario.Issues = append(scenario.Issues, ReadinessIssue{Severity: "warning", Check: "capacity", Cluster: load.Cluster,
+				Message: "node capacity unknown"})
+			continue
+		}
+		load.Fits = load.ExtraCPUMillis <= load.HeadroomCPUMillis && load.ExtraMemoryBytes <= load.HeadroomMemoryBytes
+		if !load.Fits {
+			scenario.Issues = append(scenario.Issues, ReadinessIssue{Severity: "critical", Check: "capacity", Cluster: load.Cluster,
+				Message: fmt.Sprintf("needs %dm CPU / %dMi memory for %d extra replicas, headroom is %dm / %dMi",
+					load.ExtraCPUMillis, load.ExtraMemoryBytes>>20, load.ExtraReplicas, load.HeadroomCPUMillis, load.HeadroomMemoryBytes>>20)})
+		}
+	}
+	if len(scenario.Survivors) == 0 {
+		scenario.Issues = append(scenario.Issues, ReadinessIssue{Severity: "critical", Check: "presence",
+			Message: "no other member cluster"})
+	}
+	scenario.Verdict = worseVerdict(scenario.Verdict, verdictOf(scenario.Issues))
+	return scenario
+}
+
+// eligibleFor Karmada placement가 클러스터를 허용하는지 (placement가 없으면 모두 허용)
+// Karmada 클러스터 이름은 member 클러스터 ID와 같다고 가정
+func eligibleFor(placement *WorkloadPlacement, clusterID string) bool {
+	if placement == nil {
+		return true
+	}
+	for _, excluded := range placement.ExcludedClusters {
+		if excluded == clusterID {
+			return false
+		}
+	}
+	if placement.EligibleClusters == nil {
+		return true
+	}
+	for _, eligible := range placement.EligibleClusters {
+		if eligible == clusterID {
+			return true
+		}
+	}
+	return false
+}
+
+// dividedPlacement replica를 클러스터에 나눠 배치하는지 (장애 시 Karmada가 남은 클러스터로 재스케줄)
+func dividedPlacement(placement *WorkloadPlacement) bool {
+	return placement != nil && strings.HasPrefix(placement.ReplicaScheduling, "Divided")
+}
+
+// verdictOf 문제 목록의 판정
+func verdictOf(issues []ReadinessIssue) string {
+	verdict := ReadinessReady
+	for _, issue := range issues {
+		if issue.Severity == "critical" {
+			return ReadinessNotReady
+		}
+		verdict = ReadinessAtRisk
+	}
+	return verdict
+}
+
+// worseVerdict 두 판정 중 나쁜 쪽
+func worseVerdict(a, b string) string {
+	rank := map[string]int{ReadinessReady: 0, ReadinessAtRisk: 1, ReadinessNotReady: 2}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
+
+// nodeReady 노드 Ready 조건
+func nodeReady(node corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,262 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// 기본 전달 대상 Kubernetes Event reason
+const defaultKubeEventReasons = "FailedScheduling,BackOff,Unhealthy,Evicted,NodeNotReady,FailedMount,FailedAttachVolume,FailedCreatePodSandBox,OOMKilling"
+
+// memberClusterIDs kubeconfig context 이름과 클러스터 ID
+var memberClusterIDs = map[string]string{
+	Member1ContextName: "member1",
+	Member2ContextName: "member2",
+}
+
+// KubeEventForwarder member 클러스터의 Kubernetes Event(events.k8s.io/v1)를 이벤트 로그로 전달
+type KubeEventForwarder struct {
+	clients    map[string]kubernetes.Interface // 클러스터 ID별 클라이언트
+	eventLog   *eventlog.EventLog
+	namespaces []string
+	reasons    map[string]bool   // 비어 있으면 모든 reason
+	severities map[string]string // reason별 심각도 (없으면 Warning은 warning, Normal은 info)
+	startedAt  time.Time         // 이 시각 이전에 관측된 Event는 전달하지 않음
+}
+
+// NewKubeEventForwarderFromEnv 환경변수 기반 Kubernetes Event 전달기 생성
+// KUBE_EVENT_NAMESPACES 기본값은 APP_NAMESPACE, tf-monitor, kube-system ("*"이면 전체 네임스페이스)
+// KUBE_EVENT_REASONS 기본값은 defaultKubeEventReasons ("*"이면 모든 reason)
+// KUBE_EVENT_SEVERITY="Evicted=critical,BackOff=warning" 형식으로 reason별 심각도 지정 (기본값 없음)
+func NewKubeEventForwarderFromEnv(mcm *MultiClusterMonitor, eventLog *eventlog.EventLog) *KubeEventForwarder {
+	clients := make(map[string]kubernetes.Interface, len(mcm.memberClusters))
+	for contextName, clientset := range mcm.memberClusters {
+		if id, ok := memberClusterIDs[contextName]; ok {
+			clients[id] = clientset
+		}
+	}
+	return NewKubeEventForwarder(clients, eventLog)
+}
+
+// NewKubeEventForwarder 클러스터 ID별 클라이언트로 Kubernetes Event 전달기 생성
+func NewKubeEventForwarder(clients map[string]kubernetes.Interface, eventLog *eventlog.EventLog) *KubeEventForwarder {
+	appNamespace := os.Getenv("APP_NAMESPACE")
+	if appNamespace == "" {
+		appNamespace = "default"
+	}
+
+	namespaces := splitList(getEnvDefault("KUBE_EVENT_NAMESPACES", appNamespace+",tf-monitor,kube-system"))
+	for _, ns := range namespaces {
+		if ns == "*" {
+			namespaces = []string{metav1.NamespaceAll}
+			break
+		}
+	}
+
+	reasons := make(map[string]bool)
+	for _, reason := range splitList(getEnvDefault("KUBE_EVENT_REASONS", defaultKubeEventReasons)) {
+		if reason == "*" {
+			reasons = map[string]bool{}
+			break
+		}
+		reasons[reason] = true
+	}
+
+	// 기본은 critical 없음: Kubernetes Event에는 복구 신호가 없어 critical 이벤트가 인시던트/알림을 해제하지 못함
+	// (NodeNotReady는 노드의 모든 Pod에도 기록되고, 노드 장애 자체는 클러스터 모니터가 복구와 함께 보고)
+	severities := make(map[string]string)
+	for _, item := range splitList(os.Getenv("KUBE_EVENT_SEVERITY")) {
+		reason, severity, ok := strings.Cut(item, "=")
+		severity = strings.ToLower(strings.TrimSpace(severity))
+		switch {
+		case !ok:
+			log.Printf("Warning: invalid KUBE_EVENT_SEVERITY entry %q (expected reason=severity)", item)
+		case severity != eventlog.SeverityInfo && severity != eventlog.SeverityWarning && severity != eventlog.SeverityCritical:
+			log.Printf("Warning: invalid KUBE_EVENT_SEVERITY severity %q for %s (info, warning, critical)", severity, reason)
+		default:
+			severities[strings.TrimSpace(reason)] = severity
+		}
+	}
+
+	return &KubeEventForwarder{
+		clients:    clients,
+		eventLog:   eventLog,
+		namespaces: dedupStrings(namespaces),
+		reasons:    reasons,
+		severities: severities,
+	}
+}
+
+// Run 클러스터/네임스페이스별 Event informer 실행 (블로킹)
+// 재연결과 재목록(relist)은 informer가 처리하고, 시작 이전에 관측된 Event는 건너뜀
+func (f *KubeEventForwarder) Run() {
+	if len(f.clients) == 0 {
+		log.Printf("[KubeEvents] No member cluster clients, Kubernetes Event forwarding disabled")
+		return
+	}
+
+	f.startedAt = time.Now()
+	stop := make(chan struct{})
+
+	for clusterID, client := range f.clients {
+		for _, namespace := range f.namespaces {
+			factory := informers.NewSharedInformerFactoryWithOptions(client, 0, informers.WithNamespace(namespace))
+			informer := factory.Events().V1().Events().Informer()
+
+			clusterID, namespace := clusterID, namespace
+			if err := informer.SetWatchErrorHandler(func(_ *cache.Reflector, err error) {
+				log.Printf("[KubeEvents] Watch error in %s/%s: %v", clusterID, displayNamespace(namespace), err)
+			}); err != nil {
+				log.Printf("[KubeEvents] Failed to set watch error handler: %v", err)
+			}
+
+			if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+				AddFunc: func(obj interface{}) {
+					if ev, ok := obj.(*eventsv1.Event); ok {
+						f.forward(clusterID, ev, nil)
+					}
+				},
+				UpdateFunc: func(oldObj, newObj interface{}) {
+					old, _ := oldObj.(*eventsv1.Event)
+					if ev, ok := newObj.(*eventsv1.Event); ok {
+						f.forward(clusterID, ev, old)
+					}
+				},
+			}); err != nil {
+				log.Printf("[KubeEvents] Failed to register handler for %s/%s: %v", clusterID, displayNamespace(namespace), err)
+				continue
+			}
+
+			factory.Start(stop)
+			log.Printf("[KubeEvents] Watching events in %s/%s", clusterID, displayNamespace(namespace))
+		}
+	}
+
+	<-stop
+}
+
+// forward 조건에 맞는 Event를 이벤트 로그에 기록
+// 같은 Event의 갱신(series 증가)은 관측 시각이 바뀐 경우만 전달하고, 횟수는 이벤트 로그 중복 제거로 합쳐짐
+func (f *KubeEventForwarder) forward(clusterID string, ev *eventsv1.Event, old *eventsv1.Event) {
+	if len(f.reasons) > 0 && !f.reasons[ev.Reason] {
+		return
+	}
+
+	observed := lastObserved(ev)
+	if observed.Before(f.startedAt) {
+		return
+	}
+	if old != nil && !observed.After(lastObserved(old)) {
+		return
+	}
+
+	f.eventLog.Record(f.toEvent(clusterID, ev, observed))
+}
+
+// toEvent Kubernetes Event를 대시보드 이벤트로 변환
+func (f *KubeEventForwarder) toEvent(clusterID string, ev *eventsv1.Event, observed time.Time) eventlog.Event {
+	severity, ok := f.severities[ev.Reason]
+	if !ok {
+		severity = eventlog.SeverityInfo
+		if ev.Type == corev1.EventTypeWarning {
+			severity = eventlog.SeverityWarning
+		}
+	}
+
+	eventType := "info"
+	icon := "ℹ️"
+	if severity != eventlog.SeverityInfo {
+		eventType = "critical"
+		icon = "⚠️"
+		if severity == eventlog.SeverityCritical {
+			icon = "🔴"
+		}
+	}
+
+	obj := ev.Regarding
+	name := obj.Name
+	if obj.Namespace != "" {
+		name = obj.Namespace + "/" + obj.Name
+	}
+	message := fmt.Sprintf("%s [%s] %s %s %s", icon, clusterID, obj.Kind, name, ev.Reason)
+	if note := strings.TrimSpace(ev.Note); note != "" {
+		message += ": " + note
+	}
+
+	attributes := map[string]string{
+		"reason":              ev.Reason,
+		"kubeEventType":       ev.Type,
+		"kubeEvent":           ev.Namespace + "/" + ev.Name,
+		"reportingController": ev.ReportingController,
+	}
+	if ev.Action != "" {
+		attributes["action"] = ev.Action
+	}
+	if ev.Series != nil {
+		attributes["seriesCount"] = fmt.Sprintf("%d", ev.Series.Count)
+	} else if ev.DeprecatedCount > 1 {
+		attributes["seriesCount"] = fmt.Sprintf("%d", ev.DeprecatedCount)
+	}
+
+	return eventlog.Event{
+		Type:      eventType,
+		Severity:  severity,
+		Message:   message,
+		Time:      observed,
+		Source:    "kubernetes",
+		ClusterID: clusterID,
+		InvolvedObject: &eventlog.ObjectReference{
+			Kind:      obj.Kind,
+			Namespace: obj.Namespace,
+			Name:      obj.Name,
+		},
+		Attributes: attributes,
+	}
+}
+
+// lastObserved Event가 마지막으로 관측된 시각
+// events.k8s.io/v1은 series, eventTime 순으로, core/v1에서 변환된 Event는 deprecated 필드 사용
+func lastObserved(ev *eventsv1.Event) time.Time {
+	switch {
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		return ev.Series.LastObservedTime.Time
+	case !ev.DeprecatedLastTimestamp.IsZero():
+		return ev.DeprecatedLastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	default:
+		return ev.CreationTimestamp.Time
+	}
+}
+
+// displayNamespace 로그용 네임스페이스 이름
+func displayNamespace(namespace string) string {
+	if namespace == metav1.NamespaceAll {
+		return "*"
+	}
+	return namespace
+}
+
+// dedupStrings 순서를 유지하며 중복 제거
+func dedupStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
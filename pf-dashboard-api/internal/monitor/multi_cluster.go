@@ -22,25 +22,46 @@ const (
 	Member2ContextName = "karmada-member2-ctx"
 )
 
+// ProbeHealthSource 클러스터별 합성 프로브 결과 제공자
+type ProbeHealthSource interface {
+	ClusterProbeHealth(clusterID string) (ProbeHealth, bool)
+}
+
+// PlacementSource 워크로드별 Karmada 전파 정보 제공자
+type PlacementSource interface {
+	WorkloadPlacement(kind, namespace, name string) (WorkloadPlacement, bool)
+}
+
 // MultiClusterMonitor 멀티 클러스터 모니터링
 type MultiClusterMonitor struct {
-	clusterMonitor *ClusterMonitor
-	trafficMonitor *TrafficMonitor
-	eventLog       *eventlog.EventLog
-	memberClusters map[string]*kubernetes.Clientset
-	watchers       []chan []ClusterInfo
-	mu             sync.RWMutex
-	lastStatus     map[string]string            // 이전 클러스터 상태 추적
-	lastNodeStatus map[string]map[string]string // 이전 노드 상태 추적 [clusterID][nodeName]status
+	clusterMonitor   *ClusterMonitor
+	trafficMonitor   *TrafficMonitor
+	eventLog         *eventlog.EventLog
+	memberClusters   map[string]*kubernetes.Clientset
+	watchers         []chan []ClusterInfo
+	mu               sync.RWMutex
+	lastStatus       map[string]string               // 이전 클러스터 상태 추적
+	lastNodeStatus   map[string]map[string]string    // 이전 노드 상태 추적 [clusterID][nodeName]status
+	lastPodState     map[string]map[string]*podState // 이전 Pod 상태 추적 [clusterID][namespace/name]
+	podRules         podRules                        // Pod 장애 감지 임계값
+	probeSource      ProbeHealthSource               // 합성 프로브 결과 (없으면 노드 상태만 사용)
+	resourceRules    resourceRules                   // 리소스 사용률 기반 degraded 임계값
+	metricsAvailable map[string]bool                 // 클러스터별 metrics.k8s.io 사용 가능 여부 (로그용)
+	snapshot         []ClusterInfo                   // 마지막 CheckClusters 결과 (REST API용)
+	snapshotAt       time.Time
 }
 
 // NewMultiClusterMonitor 새 멀티 클러스터 모니터 생성
 func NewMultiClusterMonitor(eventLog *eventlog.EventLog) *MultiClusterMonitor {
 	mcm := &MultiClusterMonitor{
-		eventLog:       eventLog,
-		memberClusters: make(map[string]*kubernetes.Clientset),
-		lastStatus:     make(map[string]string),
-		lastNodeStatus: make(map[string]map[string]string),
+		eventLog:         eventLog,
+		memberClusters:   make(map[string]*kubernetes.Clientset),
+		lastStatus:       make(map[string]string),
+		lastNodeStatus:   make(map[string]map[string]string),
+		lastPodState:     make(map[string]map[string]*podState),
+		podRules:         podRulesFromEnv(),
+		resourceRules:    resourceRulesFromEnv(),
+		metricsAvailable: make(map[string]bool),
 	}
 
 	// Member 클러스터 클라이언트 생성
@@ -101,6 +122,18 @@ func (mcm *MultiClusterMonitor) initMemberClusters() {
 	}
 }
 
+// SetProbeHealthSource 합성 프로브 결과를 클러스터 헬스에 반영하도록 설정
+func (mcm *MultiClusterMonitor) SetProbeHealthSource(source ProbeHealthSource) {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	mcm.probeSource = source
+}
+
+// SetPlacementSource 트래픽 그래프 노드에 Karmada 전파 정보를 붙이도록 설정
+func (mcm *MultiClusterMonitor) SetPlacementSource(source PlacementSource) {
+	mcm.trafficMonitor.setPlacementSource(source)
+}
+
 // CheckClusters 실제 클러스터 상태 체크
 func (mcm *MultiClusterMonitor) CheckClusters() []ClusterInfo {
 	clusters := make([]ClusterInfo, 0, 2)
@@ -113,21 +146,72 @@ func (mcm *MultiClusterMonitor) CheckClusters() []ClusterInfo {
 
 	// Member Cluster 1
 	member1Info := mcm.getClusterInfo(Member1ContextName, "member1", "Member1 Cluster", namespace)
+	mcm.applyResourcePressure(&member1Info)
+	mcm.applyProbeHealth(&member1Info)
 	clusters = append(clusters, member1Info)
 	mcm.checkNodeStatusChanges("member1", member1Info.Name, member1Info.Nodes)
-	mcm.checkStatusChange("member1", member1Info.Name, member1Info.Status, member1Info.Nodes)
+	mcm.checkPodFailures("member1", member1Info.Name, member1Info.PodList)
+	mcm.checkStatusChange("member1", member1Info.Name, member1Info.Status, member1Info.Reason, member1Info.Nodes)
 
 	// Member Cluster 2
 	member2Info := mcm.getClusterInfo(Member2ContextName, "member2", "Member2 Cluster", namespace)
+	mcm.applyResourcePressure(&member2Info)
+	mcm.applyProbeHealth(&member2Info)
 	clusters = append(clusters, member2Info)
 	mcm.checkNodeStatusChanges("member2", member2Info.Name, member2Info.Nodes)
-	mcm.checkStatusChange("member2", member2Info.Name, member2Info.Status, member2Info.Nodes)
+	mcm.checkPodFailures("member2", member2Info.Name, member2Info.PodList)
+	mcm.checkStatusChange("member2", member2Info.Name, member2Info.Status, member2Info.Reason, member2Info.Nodes)
+
+	mcm.mu.Lock()
+	mcm.snapshot = clusters
+	mcm.snapshotAt = time.Now()
+	mcm.mu.Unlock()
 
 	return clusters
 }
 
+// Snapshot 마지막으로 확인한 클러스터 상태와 확인 시각 (아직 확인 전이면 nil)
+// CheckClusters와 달리 API 서버를 조회하지 않음
+func (mcm *MultiClusterMonitor) Snapshot() ([]ClusterInfo, time.Time) {
+	mcm.mu.RLock()
+	defer mcm.mu.RUnlock()
+
+	return mcm.snapshot, mcm.snapshotAt
+}
+
+// applyProbeHealth 합성 프로브 결과를 클러스터 상태에 반영
+// 노드가 정상이어도 모든 프로브가 실패하면 failure, 일부만 실패하면 degraded
+func (mcm *MultiClusterMonitor) applyProbeHealth(info *ClusterInfo) {
+	mcm.mu.RLock()
+	source := mcm.probeSource
+	mcm.mu.RUnlock()
+
+	if source == nil {
+		return
+	}
+
+	health, ok := source.ClusterProbeHealth(info.ID)
+	if !ok {
+		return
+	}
+	info.Probes = &health
+
+	if info.Status != "ready" || health.Failing == 0 {
+		return
+	}
+
+	if health.Failing == health.Total {
+		info.Status = "failure"
+		info.Reason = fmt.Sprintf("all %d synthetic probes failing", health.Total)
+	} else {
+		info.Status = "degraded"
+		info.Reason = fmt.Sprintf("%d/%d synthetic probes failing", health.Failing, health.Total)
+	}
+	log.Printf("[%s] Cluster status adjusted by probes: %s (%s)", info.Name, info.Status, info.Reason)
+}
+
 // checkStatusChange 클러스터 상태 변화 감지 및 이벤트 생성
-func (mcm *MultiClusterMonitor) checkStatusChange(clusterID, clusterName, currentStatus string, nodes []NodeInfo) {
+func (mcm *MultiClusterMonitor) checkStatusChange(clusterID, clusterName, currentStatus, reason string, nodes []NodeInfo) {
 	mcm.mu.Lock()
 	defer mcm.mu.Unlock()
 
@@ -135,13 +219,19 @@ func (mcm *MultiClusterMonitor) checkStatusChange(clusterID, clusterName, curren
 
 	// 상태 변화가 있는 경우에만 이벤트 생성
 	if exists && lastStatus != currentStatus {
-		var eventType, message string
+		var eventType, severity, message string
 
 		if currentStatus == "failure" {
 			eventType = "critical"
-			message = fmt.Sprintf("🔴 %s is DOWN - No ready nodes available", clusterName)
+			severity = eventlog.SeverityCritical
+			message = fmt.Sprintf("🔴 %s is DOWN - %s", clusterName, reason)
 			log.Printf("[ALERT] %s", message)
-		} else if currentStatus == "ready" && lastStatus == "failure" {
+		} else if currentStatus == "degraded" {
+			eventType = "critical"
+			severity = eventlog.SeverityWarning
+			message = fmt.Sprintf("🟠 %s is DEGRADED - %s", clusterName, reason)
+			log.Printf("[ALERT] %s", message)
+		} else if currentStatus == "ready" && lastStatus != "ready" {
 			// Ready 노드 개수 계산
 			readyCount := 0
 			for _, node := range nodes {
@@ -151,6 +241,7 @@ func (mcm *MultiClusterMonitor) checkStatusChange(clusterID, clusterName, curren
 			}
 
 			eventType = "success"
+			severity = eventlog.SeverityInfo
 			if readyCount == len(nodes) {
 				message = fmt.Sprintf("✅ %s RECOVERED - All %d nodes are ready", clusterName, len(nodes))
 			} else {
@@ -160,7 +251,19 @@ func (mcm *MultiClusterMonitor) checkStatusChange(clusterID, clusterName, curren
 		}
 
 		if message != "" {
-			mcm.eventLog.AddEvent(eventType, message)
+			mcm.eventLog.Record(eventlog.Event{
+				Type:           eventType,
+				Severity:       severity,
+				Message:        message,
+				Source:         "monitor",
+				ClusterID:      clusterID,
+				InvolvedObject: &eventlog.ObjectReference{Kind: "Cluster", Name: clusterID},
+				Attributes: map[string]string{
+					"previousStatus": lastStatus,
+					"status":         currentStatus,
+					"reason":         reason,
+				},
+			})
 		}
 	}
 
@@ -186,6 +289,10 @@ func (mcm *MultiClusterMonitor) checkNodeStatusChanges(clusterID, clusterName st
 		// 상태 변화가 있는 경우에만 이벤트 생성
 		if exists && lastStatus != currentStatus {
 			var eventType, message string
+			attributes := map[string]string{
+				"previousStatus": lastStatus,
+				"status":         currentStatus,
+			}
 
 			if currentStatus == "Ready" && lastStatus != "Ready" {
 				eventType = "success"
@@ -194,11 +301,30 @@ func (mcm *MultiClusterMonitor) checkNodeStatusChanges(clusterID, clusterName st
 			} else if currentStatus != "Ready" && lastStatus == "Ready" {
 				eventType = "critical"
 				message = fmt.Sprintf("🔴 Node %s in %s is now NOT READY", node.Name, clusterName)
+				if cause := node.NotReady; cause != nil {
+					message += " - " + cause.summary()
+					attributes["reason"] = cause.Reason
+					attributes["conditionMessage"] = cause.Message
+					attributes["downFor"] = cause.DownFor
+					if !cause.Since.IsZero() {
+						attributes["notReadySince"] = cause.Since.Format(time.RFC3339)
+					}
+					if len(cause.Conditions) > 0 {
+						attributes["conditions"] = strings.Join(cause.Conditions, ", ")
+					}
+				}
 				log.Printf("[ALERT] %s", message)
 			}
 
 			if message != "" {
-				mcm.eventLog.AddEvent(eventType, message)
+				mcm.eventLog.Record(eventlog.Event{
+					Type:           eventType,
+					Message:        message,
+					Source:         "monitor",
+					ClusterID:      clusterID,
+					InvolvedObject: &eventlog.ObjectReference{Kind: "Node", Name: node.Name},
+					Attributes:     attributes,
+				})
 			}
 		}
 
@@ -224,6 +350,7 @@ func (mcm *MultiClusterMonitor) getClusterInfo(contextName, id, name, namespace
 	if !exists {
 		log.Printf("Clientset for %s not found", contextName)
 		info.Status = "failure"
+		info.Reason = "no API client configured"
 		return info
 	}
 
@@ -234,6 +361,7 @@ func (mcm *MultiClusterMonitor) getClusterInfo(contextName, id, name, namespace
 	if err != nil {
 		log.Printf("Failed to list nodes in %s: %v", contextName, err)
 		info.Status = "failure"
+		info.Reason = "API server unreachable"
 		return info
 	}
 
@@ -251,12 +379,16 @@ func (mcm *MultiClusterMonitor) getClusterInfo(contextName, id, name, namespace
 	// 노드가 없거나 Ready 노드가 하나도 없으면 failure
 	if len(nodes.Items) == 0 || readyNodeCount == 0 {
 		info.Status = "failure"
+		info.Reason = "No ready nodes available"
 		log.Printf("[%s] Cluster status: FAILURE (Ready nodes: %d/%d)", name, readyNodeCount, len(nodes.Items))
 	} else {
 		info.Status = "ready"
 		log.Printf("[%s] Cluster status: READY (Ready nodes: %d/%d)", name, readyNodeCount, len(nodes.Items))
 	}
 
+	// 노드 리소스와 사용률 (metrics.k8s.io가 없으면 요청/제한만)
+	mcm.collectNodeResources(ctx, clientset, &info, nodes.Items)
+
 	// Pod 목록 조회 (tf-monitor 네임스페이스)
 	pods, err := clientset.CoreV1().Pods("tf-monitor").List(ctx, metav1.ListOptions{
 		// LabelSelector를 제거하여 네임스페이스의 모든 Pod 조회
@@ -341,16 +473,31 @@ func (mcm *MultiClusterMonitor) extractNodeInfo(node *corev1.Node) NodeInfo {
 	// Container Runtime
 	nodeInfo.ContainerRuntime = node.Status.NodeInfo.ContainerRuntimeVersion
 
+	// 조건, taint, cordon, 토폴로지
+	nodeInfo.Conditions = nodeConditions(node)
+	nodeInfo.Taints = nodeTaints(node)
+	nodeInfo.Unschedulable = node.Spec.Unschedulable
+	nodeInfo.Labels = node.Labels
+	nodeInfo.Zone = firstLabel(node.Labels, zoneLabels)
+	nodeInfo.Region = firstLabel(node.Labels, regionLabels)
+	if nodeInfo.Status != "Ready" {
+		nodeInfo.NotReady = notReadyCause(nodeInfo.Conditions, time.Now())
+	}
+
 	return nodeInfo
 }
 
 // extractPodInfo Pod 상세 정보 추출
 func (mcm *MultiClusterMonitor) extractPodInfo(pod *corev1.Pod) PodInfo {
 	podInfo := PodInfo{
-		Name:   pod.Name,
-		Status: string(pod.Status.Phase),
-		IP:     pod.Status.PodIP,
-		Node:   pod.Spec.NodeName,
+		Name:       pod.Name,
+		Namespace:  pod.Namespace,
+		Status:     string(pod.Status.Phase),
+		IP:         pod.Status.PodIP,
+		Node:       pod.Spec.NodeName,
+		Labels:     pod.Labels,
+		CreatedAt:  pod.CreationTimestamp.Time,
+		Containers: make([]ContainerInfo, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses)),
 	}
 
 	// Ready (ready containers / total containers)
@@ -370,6 +517,17 @@ func (mcm *MultiClusterMonitor) extractPodInfo(pod *corev1.Pod) PodInfo {
 	}
 	podInfo.Restarts = totalRestarts
 
+	// 컨테이너별 상태
+	for _, containerStatus := range pod.Status.InitContainerStatuses {
+		container := extractContainerInfo(containerStatus)
+		container.Init = true
+		podInfo.Containers = append(podInfo.Containers, container)
+	}
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		podInfo.Containers = append(podInfo.Containers, extractContainerInfo(containerStatus))
+	}
+	podInfo.Reason, podInfo.Message = podReason(pod, podInfo.Containers)
+
 	// Age
 	age := time.Since(pod.CreationTimestamp.Time)
 	podInfo.Age = formatDuration(age)
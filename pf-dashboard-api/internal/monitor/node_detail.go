@@ -0,0 +1,235 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+var (
+	// ErrClusterNotFound 클러스터 ID에 해당하는 member 클러스터 클라이언트가 없음
+	ErrClusterNotFound = errors.New("cluster not found")
+	// ErrNodeNotFound 클러스터에 노드가 없음
+	ErrNodeNotFound = errors.New("node not found")
+)
+
+// 노드 토폴로지 라벨 (beta 라벨은 구버전 클러스터 호환)
+var (
+	zoneLabels   = []string{corev1.LabelTopologyZone, corev1.LabelFailureDomainBetaZone}
+	regionLabels = []string{corev1.LabelTopologyRegion, corev1.LabelFailureDomainBetaRegion}
+)
+
+// NodeCondition 노드 조건 (Ready, MemoryPressure, DiskPressure, PIDPressure, NetworkUnavailable ...)
+type NodeCondition struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"` // True, False, Unknown
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+	LastHeartbeatTime  time.Time `json:"lastHeartbeatTime"`
+}
+
+// NodeTaint 노드 taint
+type NodeTaint struct {
+	Key       string     `json:"key"`
+	Value     string     `json:"value,omitempty"`
+	Effect    string     `json:"effect"` // NoSchedule, PreferNoSchedule, NoExecute
+	TimeAdded *time.Time `json:"timeAdded,omitempty"`
+}
+
+// NodeNotReadyCause NotReady 노드의 원인
+type NodeNotReadyCause struct {
+	Reason     string    `json:"reason"`               // Ready 조건 reason (KubeletNotReady, NodeStatusUnknown ...)
+	Message    string    `json:"message,omitempty"`    // Ready 조건 message
+	Since      time.Time `json:"since"`                // Ready 조건이 마지막으로 바뀐 시각
+	DownFor    string    `json:"downFor"`              // Since부터 경과 시간 (2m, 3h ...)
+	Conditions []string  `json:"conditions,omitempty"` // 함께 비정상인 조건 (MemoryPressure: KubeletHasInsufficientMemory ...)
+}
+
+// NodeAddress 노드 주소
+type NodeAddress struct {
+	Type    string `json:"type"` // InternalIP, ExternalIP, Hostname
+	Address string `json:"address"`
+}
+
+// NodeDetail 노드 상세 정보 (노드 목록 필드 + 주소, 노드의 Pod)
+type NodeDetail struct {
+	NodeInfo
+	ClusterID    string        `json:"clusterId"`
+	Addresses    []NodeAddress `json:"addresses"`
+	ProviderID   string        `json:"providerID,omitempty"`
+	PodCIDRs     []string      `json:"podCIDRs,omitempty"`
+	Architecture string        `json:"architecture"`
+	Pods         []PodInfo     `json:"pods"` // 노드에 스케줄된 모든 Pod
+}
+
+// NodeDetail 클러스터 노드 하나를 API 서버에서 직접 조회
+func (mcm *MultiClusterMonitor) NodeDetail(ctx context.Context, clusterID, name string) (*NodeDetail, error) {
+	clientset, ok := mcm.clientForCluster(clusterID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrClusterNotFound, clusterID)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	node, err := clientset.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s/%s", ErrNodeNotFound, clusterID, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get node %s/%s: %w", clusterID, name, err)
+	}
+
+	detail := &NodeDetail{
+		NodeInfo:     mcm.extractNodeInfo(node),
+		ClusterID:    clusterID,
+		Addresses:    make([]NodeAddress, 0, len(node.Status.Addresses)),
+		ProviderID:   node.Spec.ProviderID,
+		PodCIDRs:     node.Spec.PodCIDRs,
+		Architecture: node.Status.NodeInfo.Architecture,
+		Pods:         []PodInfo{},
+	}
+	detail.Capacity = nodeResourcesOf(node.Status.Capacity)
+	detail.Allocatable = nodeResourcesOf(node.Status.Allocatable)
+	for _, addr := range node.Status.Addresses {
+		detail.Addresses = append(detail.Addresses, NodeAddress{Type: string(addr.Type), Address: addr.Address})
+	}
+
+	pods, err := clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{FieldSelector: "spec.nodeName=" + name})
+	if err != nil {
+		return nil, fmt.Errorf("list pods on node %s/%s: %w", clusterID, name, err)
+	}
+	for _, pod := range pods.Items {
+		detail.Pods = append(detail.Pods, mcm.extractPodInfo(&pod))
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		addResources(&detail.Requests, podResources(pod.Spec, false))
+		addResources(&detail.Limits, podResources(pod.Spec, true))
+		detail.Requests.Pods++
+	}
+	sort.Slice(detail.Pods, func(i, j int) bool {
+		if detail.Pods[i].Namespace != detail.Pods[j].Namespace {
+			return detail.Pods[i].Namespace < detail.Pods[j].Namespace
+		}
+		return detail.Pods[i].Name < detail.Pods[j].Name
+	})
+
+	// metrics-server가 없으면 사용량 없이 반환
+	if usage, err := fetchNodeMetrics(ctx, clientset); err == nil {
+		if u, ok := usage[name]; ok {
+			detail.Usage = &u
+		}
+	}
+	return detail, nil
+}
+
+// clientForCluster 클러스터 ID의 member 클러스터 클라이언트
+func (mcm *MultiClusterMonitor) clientForCluster(clusterID string) (kubernetes.Interface, bool) {
+	for contextName, id := range memberClusterIDs {
+		if id != clusterID {
+			continue
+		}
+		clientset, ok := mcm.memberClusters[contextName]
+		return clientset, ok
+	}
+	return nil, false
+}
+
+// nodeConditions 노드 조건 목록 (Ready를 맨 앞에)
+func nodeConditions(node *corev1.Node) []NodeCondition {
+	conditions := make([]NodeCondition, 0, len(node.Status.Conditions))
+	for _, c := range node.Status.Conditions {
+		conditions = append(conditions, NodeCondition{
+			Type:               string(c.Type),
+			Status:             string(c.Status),
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime.Time,
+			LastHeartbeatTime:  c.LastHeartbeatTime.Time,
+		})
+	}
+	sort.SliceStable(conditions, func(i, j int) bool {
+		return conditions[i].Type == string(corev1.NodeReady) && conditions[j].Type != string(corev1.NodeReady)
+	})
+	return conditions
+}
+
+// nodeTaints 노드 taint 목록
+func nodeTaints(node *corev1.Node) []NodeTaint {
+	taints := make([]NodeTaint, 0, len(node.Spec.Taints))
+	for _, t := range node.Spec.Taints {
+		taint := NodeTaint{Key: t.Key, Value: t.Value, Effect: string(t.Effect)}
+		if t.TimeAdded != nil {
+			added := t.TimeAdded.Time
+			taint.TimeAdded = &added
+		}
+		taints = append(taints, taint)
+	}
+	return taints
+}
+
+// firstLabel 라벨 후보 중 처음 있는 값
+func firstLabel(labels map[string]string, keys []string) string {
+	for _, key := range keys {
+		if value := labels[key]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// notReadyCause Ready 조건이 True가 아닐 때 원인과 경과 시간 (Ready면 nil)
+func notReadyCause(conditions []NodeCondition, now time.Time) *NodeNotReadyCause {
+	var cause *NodeNotReadyCause
+	for _, c := range conditions {
+		if c.Type == string(corev1.NodeReady) {
+			if c.Status == string(corev1.ConditionTrue) {
+				return nil
+			}
+			cause = &NodeNotReadyCause{Reason: c.Reason, Message: c.Message, Since: c.LastTransitionTime}
+			if cause.Reason == "" {
+				cause.Reason = "Ready=" + c.Status
+			}
+			if !c.LastTransitionTime.IsZero() {
+				cause.DownFor = formatDuration(now.Sub(c.LastTransitionTime))
+			}
+		}
+	}
+	if cause == nil {
+		return &NodeNotReadyCause{Reason: "NoReadyCondition"}
+	}
+
+	// 압박/네트워크 조건은 True가 비정상 (kubelet 응답이 없으면 모두 Unknown이라 제외)
+	for _, c := range conditions {
+		if c.Type == string(corev1.NodeReady) || c.Status != string(corev1.ConditionTrue) {
+			continue
+		}
+		cause.Conditions = append(cause.Conditions, strings.TrimSuffix(c.Type+": "+c.Reason, ": "))
+	}
+	return cause
+}
+
+// summary 이벤트 메시지용 한 줄 요약
+func (c *NodeNotReadyCause) summary() string {
+	text := c.Reason
+	if c.Message != "" {
+		text += ": " + c.Message
+	}
+	if len(c.Conditions) > 0 {
+		text += " [" + strings.Join(c.Conditions, ", ") + "]"
+	}
+	if c.DownFor != "" {
+		text += " (down for " + c.DownFor + ")"
+	}
+	return text
+}
@@ -0,0 +1,291 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// metrics-server가 제공하는 노드 사용량 API
+const nodeMetricsPath = "/apis/metrics.k8s.io/v1beta1/nodes"
+
+// NodeResources 노드 리소스 양 (CPU는 밀리코어, 메모리/임시 스토리지는 바이트)
+type NodeResources struct {
+	CPUMillis             int64 `json:"cpuMillis"`
+	MemoryBytes           int64 `json:"memoryBytes"`
+	Pods                  int64 `json:"pods"`
+	EphemeralStorageBytes int64 `json:"ephemeralStorageBytes"`
+}
+
+// NodeUsage metrics.k8s.io 실측 사용량
+type NodeUsage struct {
+	CPUMillis   int64     `json:"cpuMillis"`
+	MemoryBytes int64     `json:"memoryBytes"`
+	Timestamp   time.Time `json:"timestamp"`
+	Window      string    `json:"window,omitempty"`
+}
+
+// ClusterUtilization Ready 노드 기준 클러스터 리소스 사용률 (퍼센트는 allocatable 대비)
+type ClusterUtilization struct {
+	Nodes                int           `json:"nodes"` // 집계한 Ready 노드 수
+	Allocatable          NodeResources `json:"allocatable"`
+	Requests             NodeResources `json:"requests"`
+	Limits               NodeResources `json:"limits"`
+	Usage                *NodeUsage    `json:"usage,omitempty"` // metrics-server가 없으면 nil
+	CPURequestPercent    float64       `json:"cpuRequestPercent"`
+	MemoryRequestPercent float64       `json:"memoryRequestPercent"`
+	PodsPercent          float64       `json:"podsPercent"`
+	CPUUsagePercent      *float64      `json:"cpuUsagePercent,omitempty"`
+	MemoryUsagePercent   *float64      `json:"memoryUsagePercent,omitempty"`
+}
+
+// resourceRules 리소스 사용률 기반 degraded 임계값 (0이면 비활성)
+type resourceRules struct {
+	cpuPercent    float64
+	memoryPercent float64
+}
+
+// resourceRulesFromEnv 환경변수 기반 리소스 사용률 임계값
+func resourceRulesFromEnv() resourceRules {
+	return resourceRules{
+		cpuPercent:    float64(getIntEnv("CLUSTER_CPU_DEGRADED_PERCENT", 90)),
+		memoryPercent: float64(getIntEnv("CLUSTER_MEMORY_DEGRADED_PERCENT", 90)),
+	}
+}
+
+// nodeMetricsList metrics.k8s.io NodeMetricsList 중 필요한 필드
+type nodeMetricsList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Timestamp time.Time           `json:"timestamp"`
+		Window    string              `json:"window"`
+		Usage     corev1.ResourceList `json:"usage"`
+	} `json:"items"`
+}
+
+// collectNodeResources 노드별 capacity/allocatable, Pod 요청/제한 합계, 실측 사용량을 채우고 클러스터 사용률 계산
+func (mcm *MultiClusterMonitor) collectNodeResources(ctx context.Context, clientset kubernetes.Interface, info *ClusterInfo, nodes []corev1.Node) {
+	byName := make(map[string]*NodeInfo, len(info.Nodes))
+	for i := range info.Nodes {
+		byName[info.Nodes[i].Name] = &info.Nodes[i]
+	}
+	for _, node := range nodes {
+		if nodeInfo := byName[node.Name]; nodeInfo != nil {
+			nodeInfo.Capacity = nodeResourcesOf(node.Status.Capacity)
+			nodeInfo.Allocatable = nodeResourcesOf(node.Status.Allocatable)
+		}
+	}
+
+	listCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	pods, err := clientset.CoreV1().Pods("").List(listCtx, metav1.ListOptions{
+		FieldSelector: "status.phase!=Succeeded,status.phase!=Failed",
+	})
+	if err != nil {
+		log.Printf("Warning: [%s] Failed to list pods for resource requests: %v", info.Name, err)
+	} else {
+		for _, pod := range pods.Items {
+			nodeInfo := byName[pod.Spec.NodeName]
+			if nodeInfo == nil {
+				continue
+			}
+			addResources(&nodeInfo.Requests, podResources(pod.Spec, false))
+			addResources(&nodeInfo.Limits, podResources(pod.Spec, true))
+			nodeInfo.Requests.Pods++
+		}
+	}
+
+	usage, err := fetchNodeMetrics(listCtx, clientset)
+	mcm.recordMetricsAvailability(info, err)
+	for name, u := range usage {
+		if nodeInfo := byName[name]; nodeInfo != nil {
+			u := u
+			nodeInfo.Usage = &u
+		}
+	}
+
+	info.Utilization = clusterUtilization(info.Nodes)
+}
+
+// recordMetricsAvailability metrics.k8s.io 사용 가능 여부가 바뀔 때만 로그
+func (mcm *MultiClusterMonitor) recordMetricsAvailability(info *ClusterInfo, err error) {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+
+	available := err == nil
+	if last, exists := mcm.metricsAvailable[info.ID]; exists && last == available {
+		return
+	}
+	mcm.metricsAvailable[info.ID] = available
+
+	if available {
+		log.Printf("[%s] metrics.k8s.io available, collecting node usage", info.Name)
+	} else {
+		log.Printf("Warning: [%s] metrics.k8s.io unavailable, node usage disabled: %v", info.Name, err)
+	}
+}
+
+// applyResourcePressure 실측 CPU/메모리 사용률이 임계값 이상이면 ready 클러스터를 degraded로 변경
+func (mcm *MultiClusterMonitor) applyResourcePressure(info *ClusterInfo) {
+	u := info.Utilization
+	if info.Status != "ready" || u == nil {
+		return
+	}
+
+	reasons := make([]string, 0, 2)
+	if u.CPUUsagePercent != nil && mcm.resourceRules.cpuPercent > 0 && *u.CPUUsagePercent >= mcm.resourceRules.cpuPercent {
+		reasons = append(reasons, fmt.Sprintf("CPU usage %.0f%% of allocatable", *u.CPUUsagePercent))
+	}
+	if u.MemoryUsagePercent != nil && mcm.resourceRules.memoryPercent > 0 && *u.MemoryUsagePercent >= mcm.resourceRules.memoryPercent {
+		reasons = append(reasons, fmt.Sprintf("memory usage %.0f%% of allocatable", *u.MemoryUsagePercent))
+	}
+	if len(reasons) == 0 {
+		return
+	}
+
+	info.Status = "degraded"
+	info.Reason = strings.Join(reasons, ", ")
+	log.Printf("[%s] Cluster status adjusted by resource usage: %s (%s)", info.Name, info.Status, info.Reason)
+}
+
+// fetchNodeMetrics metrics.k8s.io에서 노드별 사용량 조회 (metrics-server가 없으면 에러)
+func fetchNodeMetrics(ctx context.Context, clientset kubernetes.Interface) (map[string]NodeUsage, error) {
+	body, err := clientset.CoreV1().RESTClient().Get().AbsPath(nodeMetricsPath).DoRaw(ctx)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("metrics-server not installed")
+		}
+		return nil, err
+	}
+
+	var list nodeMetricsList
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("decode node metrics: %w", err)
+	}
+
+	usage := make(map[string]NodeUsage, len(list.Items))
+	for _, item := range list.Items {
+		usage[item.Metadata.Name] = NodeUsage{
+			CPUMillis:   item.Usage.Cpu().MilliValue(),
+			MemoryBytes: item.Usage.Memory().Value(),
+			Timestamp:   item.Timestamp,
+			Window:      item.Window,
+		}
+	}
+	return usage, nil
+}
+
+// clusterUtilization Ready 노드만 합산한 클러스터 사용률
+// 사용량은 모든 Ready 노드의 metrics가 있을 때만 계산
+func clusterUtilization(nodes []NodeInfo) *ClusterUtilization {
+	u := &ClusterUtilization{}
+	usage := &NodeUsage{}
+	for _, node := range nodes {
+		if node.Status != "Ready" {
+			continue
+		}
+		u.Nodes++
+		addResources(&u.Allocatable, node.Allocatable)
+		addResources(&u.Requests, node.Requests)
+		addResources(&u.Limits, node.Limits)
+
+		if usage != nil && node.Usage != nil {
+			usage.CPUMillis += node.Usage.CPUMillis
+			usage.MemoryBytes += node.Usage.MemoryBytes
+			if node.Usage.Timestamp.After(usage.Timestamp) {
+				usage.Timestamp = node.Usage.Timestamp
+			}
+		} else {
+			usage = nil
+		}
+	}
+	if u.Nodes == 0 {
+		return u
+	}
+
+	u.CPURequestPercent = percentOf(u.Requests.CPUMillis, u.Allocatable.CPUMillis)
+	u.MemoryRequestPercent = percentOf(u.Requests.MemoryBytes, u.Allocatable.MemoryBytes)
+	u.PodsPercent = percentOf(u.Requests.Pods, u.Allocatable.Pods)
+	if usage != nil {
+		cpu := percentOf(usage.CPUMillis, u.Allocatable.CPUMillis)
+		memory := percentOf(usage.MemoryBytes, u.Allocatable.MemoryBytes)
+		u.Usage = usage
+		u.CPUUsagePercent = &cpu
+		u.MemoryUsagePercent = &memory
+	}
+	return u
+}
+
+// nodeResourcesOf ResourceList를 NodeResources로 변환
+func nodeResourcesOf(list corev1.ResourceList) NodeResources {
+	return NodeResources{
+		CPUMillis:             list.Cpu().MilliValue(),
+		MemoryBytes:           list.Memory().Value(),
+		Pods:                  list.Pods().Value(),
+		EphemeralStorageBytes: list.StorageEphemeral().Value(),
+	}
+}
+
+// podResources Pod 하나의 요청(limits가 true면 제한) 합계
+// 스케줄러와 같이 컨테이너 합계와 init 컨테이너 최댓값 중 큰 값에 overhead를 더함
+func podResources(spec corev1.PodSpec, limits bool) NodeResources {
+	of := func(c corev1.Container) corev1.ResourceList {
+		if limits {
+			return c.Resources.Limits
+		}
+		return c.Resources.Requests
+	}
+
+	total := corev1.ResourceList{}
+	for _, c := range spec.Containers {
+		for name, q := range of(c) {
+			sum := total[name]
+			sum.Add(q)
+			total[name] = sum
+		}
+	}
+	for _, c := range spec.InitContainers {
+		for name, q := range of(c) {
+			if current, ok := total[name]; !ok || q.Cmp(current) > 0 {
+				total[name] = q.DeepCopy()
+			}
+		}
+	}
+	for name, q := range spec.Overhead {
+		sum := total[name]
+		sum.Add(q)
+		total[name] = sum
+	}
+
+	resources := nodeResourcesOf(total)
+	resources.Pods = 0
+	return resources
+}
+
+// addResources dst에 src를 더함
+func addResources(dst *NodeResources, src NodeResources) {
+	dst.CPUMillis += src.CPUMillis
+	dst.MemoryBytes += src.MemoryBytes
+	dst.Pods += src.Pods
+	dst.EphemeralStorageBytes += src.EphemeralStorageBytes
+}
+
+// percentOf 소수점 한 자리 퍼센트 (total이 0이면 0)
+func percentOf(value, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return math.Round(float64(value)*1000/float64(total)) / 10
+}
@@ -0,0 +1,303 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// podState Pod 장애 감지를 위한 이전 상태
+type podState struct {
+	restarts  int32
+	crashLoop bool      // CrashLoopBackOff 이벤트를 기록했고 아직 복구되지 않음
+	pending   bool      // 장기 Pending 이벤트를 기록했고 아직 복구되지 않음
+	oomAt     time.Time // 마지막으로 기록(또는 확인)한 OOMKilled 종료 시각
+}
+
+// podRules Pod 장애 감지 임계값
+type podRules struct {
+	pendingThreshold time.Duration // 이 시간 이상 Pending이면 이벤트
+	restartSpike     int32         // 이전 확인 이후 재시작 증가가 이 값 이상이면 이벤트
+}
+
+// podRulesFromEnv 환경변수 기반 Pod 장애 감지 임계값
+func podRulesFromEnv() podRules {
+	return podRules{
+		pendingThreshold: getDurationEnv("POD_PENDING_THRESHOLD", 5*time.Minute),
+		restartSpike:     int32(getIntEnv("POD_RESTART_SPIKE", 2)),
+	}
+}
+
+// extractContainerInfo 컨테이너 상태 추출
+func extractContainerInfo(status corev1.ContainerStatus) ContainerInfo {
+	container := ContainerInfo{
+		Name:     status.Name,
+		Ready:    status.Ready,
+		Restarts: status.RestartCount,
+	}
+
+	switch state := status.State; {
+	case state.Waiting != nil:
+		container.State = "waiting"
+		container.Reason = state.Waiting.Reason
+		container.Message = state.Waiting.Message
+	case state.Running != nil:
+		container.State = "running"
+		startedAt := state.Running.StartedAt.Time
+		container.StartedAt = &startedAt
+	case state.Terminated != nil:
+		container.State = "terminated"
+		container.Reason = state.Terminated.Reason
+		container.Message = state.Terminated.Message
+		exitCode := state.Terminated.ExitCode
+		container.ExitCode = &exitCode
+		finishedAt := state.Terminated.FinishedAt.Time
+		container.FinishedAt = &finishedAt
+	}
+
+	if last := status.LastTerminationState.Terminated; last != nil {
+		container.LastTermination = &TerminationInfo{
+			Reason:     last.Reason,
+			ExitCode:   last.ExitCode,
+			Message:    last.Message,
+			FinishedAt: last.FinishedAt.Time,
+		}
+	}
+	return container
+}
+
+// podReason kubectl STATUS 열과 같은 방식으로 Pod의 대표 원인과 설명 결정
+// phase와 같으면 빈 문자열 반환
+func podReason(pod *corev1.Pod, containers []ContainerInfo) (string, string) {
+	reason := string(pod.Status.Phase)
+	message := ""
+
+	if pod.Status.Reason != "" {
+		reason, message = pod.Status.Reason, pod.Status.Message
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable {
+			reason, message = cond.Reason, cond.Message
+		}
+	}
+
+	initializing := false
+	for _, c := range containers {
+		if !c.Init {
+			continue
+		}
+		switch {
+		case c.State == "terminated" && c.ExitCode != nil && *c.ExitCode == 0:
+			continue
+		case c.State == "terminated":
+			reason = "Init:" + containerReason(c)
+		case c.State == "waiting" && c.Reason != "" && c.Reason != "PodInitializing":
+			reason, message = "Init:"+c.Reason, c.Message
+		default:
+			reason = "Init:Running"
+		}
+		initializing = true
+		break
+	}
+
+	if !initializing {
+		// waiting 원인(CrashLoopBackOff, ImagePullBackOff 등)을 종료 원인보다 우선
+		for _, c := range containers {
+			if !c.Init && c.State == "terminated" {
+				reason, message = containerReason(c), c.Message
+			}
+		}
+		for _, c := range containers {
+			if !c.Init && c.State == "waiting" && c.Reason != "" {
+				reason, message = c.Reason, c.Message
+			}
+		}
+	}
+
+	if pod.DeletionTimestamp != nil {
+		reason = "Terminating"
+	}
+
+	if reason == string(pod.Status.Phase) {
+		return "", message
+	}
+	return reason, message
+}
+
+// containerReason 종료된 컨테이너의 원인 (원인이 없으면 종료 코드)
+func containerReason(c ContainerInfo) string {
+	if c.Reason != "" {
+		return c.Reason
+	}
+	if c.ExitCode != nil {
+		return fmt.Sprintf("ExitCode:%d", *c.ExitCode)
+	}
+	return "Terminated"
+}
+
+// checkPodFailures Pod별 장애(CrashLoopBackOff, OOMKilled, 장기 Pending, 재시작 급증) 감지 및 이벤트 생성
+// 클러스터를 처음 확인할 때는 현재 상태만 기록하고, 이후 새로 생긴 Pod는 처음부터 검사
+func (mcm *MultiClusterMonitor) checkPodFailures(clusterID, clusterName string, pods []PodInfo) {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+
+	states, seeded := mcm.lastPodState[clusterID]
+	if !seeded {
+		states = make(map[string]*podState)
+		mcm.lastPodState[clusterID] = states
+	}
+
+	now := time.Now()
+	seen := make(map[string]bool, len(pods))
+	for _, pod := range pods {
+		key := pod.Namespace + "/" + pod.Name
+		seen[key] = true
+
+		state, exists := states[key]
+		if !exists {
+			state = &podState{restarts: pod.Restarts}
+			states[key] = state
+		}
+
+		subject := fmt.Sprintf("Pod %s in %s", key, clusterName)
+		for _, event := range mcm.podRules.evaluate(subject, pod, state, exists, seeded, now) {
+			event.Source = "monitor"
+			event.ClusterID = clusterID
+			event.InvolvedObject = &eventlog.ObjectReference{Kind: "Pod", Namespace: pod.Namespace, Name: pod.Name}
+			log.Printf("[ALERT] %s", event.Message)
+			mcm.eventLog.Record(event)
+		}
+	}
+
+	// Pod 조회 실패로 목록이 비어 있으면 이전 상태 유지
+	if len(pods) == 0 {
+		return
+	}
+	for key := range states {
+		if !seen[key] {
+			delete(states, key)
+		}
+	}
+}
+
+// evaluate Pod 하나에 감지 규칙 적용 후 state 갱신
+// seeded가 false면(클러스터 첫 확인) 이벤트 없이 상태만 기록
+func (r podRules) evaluate(subject string, pod PodInfo, state *podState, exists, seeded bool, now time.Time) []eventlog.Event {
+	events := make([]eventlog.Event, 0)
+	warn := func(reason, message string, attributes map[string]string) {
+		if !seeded {
+			return
+		}
+		attributes["reason"] = reason
+		events = append(events, eventlog.Event{
+			Type:       "critical",
+			Severity:   eventlog.SeverityWarning,
+			Message:    "🟠 " + subject + " " + message,
+			Attributes: attributes,
+		})
+	}
+
+	// CrashLoopBackOff
+	for _, c := range pod.Containers {
+		if c.State != "waiting" || c.Reason != "CrashLoopBackOff" || state.crashLoop {
+			continue
+		}
+		state.crashLoop = true
+
+		detail := fmt.Sprintf("container %s", c.Name)
+		attributes := map[string]string{"container": c.Name, "restarts": fmt.Sprintf("%d", c.Restarts)}
+		if last := c.LastTermination; last != nil {
+			detail += fmt.Sprintf(" exited %d (%s)", last.ExitCode, last.Reason)
+			attributes["exitCode"] = fmt.Sprintf("%d", last.ExitCode)
+			attributes["lastTerminationReason"] = last.Reason
+		}
+		warn("CrashLoopBackOff", fmt.Sprintf("is in CrashLoopBackOff - %s, %d restarts", detail, c.Restarts), attributes)
+	}
+
+	// OOMKilled (현재 종료 상태 또는 직전 종료 원인)
+	for _, c := range pod.Containers {
+		var finishedAt time.Time
+		var exitCode int32
+		switch {
+		case c.State == "terminated" && c.Reason == "OOMKilled" && c.FinishedAt != nil:
+			finishedAt, exitCode = *c.FinishedAt, *c.ExitCode
+		case c.LastTermination != nil && c.LastTermination.Reason == "OOMKilled":
+			finishedAt, exitCode = c.LastTermination.FinishedAt, c.LastTermination.ExitCode
+		default:
+			continue
+		}
+		if !finishedAt.After(state.oomAt) {
+			continue
+		}
+		state.oomAt = finishedAt
+
+		warn("OOMKilled", fmt.Sprintf("container %s was OOMKilled (exit code %d)", c.Name, exitCode), map[string]string{
+			"container":  c.Name,
+			"exitCode":   fmt.Sprintf("%d", exitCode),
+			"finishedAt": finishedAt.Format(time.RFC3339),
+		})
+	}
+
+	// 장기 Pending
+	if pending := now.Sub(pod.CreatedAt); pod.Status == string(corev1.PodPending) && pending >= r.pendingThreshold && !state.pending {
+		state.pending = true
+
+		message := fmt.Sprintf("has been Pending for %s", formatDuration(pending))
+		if pod.Reason != "" {
+			message += " (" + pod.Reason + ")"
+		}
+		if pod.Message != "" {
+			message += " - " + pod.Message
+		}
+		warn("Pending", message, map[string]string{
+			"podReason": pod.Reason,
+			"message":   pod.Message,
+		})
+	}
+
+	// 재시작 급증
+	if increase := pod.Restarts - state.restarts; exists && r.restartSpike > 0 && increase >= r.restartSpike {
+		warn("RestartSpike", fmt.Sprintf("restarted %d times since last check (total %d)", increase, pod.Restarts), map[string]string{
+			"increase": fmt.Sprintf("%d", increase),
+			"restarts": fmt.Sprintf("%d", pod.Restarts),
+		})
+	}
+	state.restarts = pod.Restarts
+
+	// 복구 (Running이고 모든 컨테이너 Ready)
+	if (state.crashLoop || state.pending) && podReady(pod) {
+		state.crashLoop, state.pending = false, false
+		if seeded {
+			events = append(events, eventlog.Event{
+				Type:       "success",
+				Severity:   eventlog.SeverityInfo,
+				Message:    "✅ " + subject + " RECOVERED - " + pod.Ready + " containers ready",
+				Attributes: map[string]string{"reason": "Recovered"},
+			})
+		}
+	}
+
+	return events
+}
+
+// podReady Pod가 Running이고 모든 (init 제외) 컨테이너가 Ready인지
+func podReady(pod PodInfo) bool {
+	if pod.Status != string(corev1.PodRunning) {
+		return false
+	}
+	ready := 0
+	for _, c := range pod.Containers {
+		if c.Init {
+			continue
+		}
+		if !c.Ready {
+			return false
+		}
+		ready++
+	}
+	return ready > 0 && !strings.HasPrefix(pod.Reason, "Init:")
+}
@@ -0,0 +1,454 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// 롤아웃 단계
+const (
+	RolloutProgressing = "progressing"
+	RolloutComplete    = "complete"
+	RolloutStalled     = "stalled"
+)
+
+// revisionAnnotation Deployment 컨트롤러가 기록하는 revision
+const revisionAnnotation = "deployment.kubernetes.io/revision"
+
+// DeploymentCondition Deployment 상태 조건 (Progressing, Available)
+type DeploymentCondition struct {
+	Status         string    `json:"status"`
+	Reason         string    `json:"reason,omitempty"`
+	Message        string    `json:"message,omitempty"`
+	LastUpdateTime time.Time `json:"lastUpdateTime"`
+}
+
+// ClusterRollout 클러스터 하나의 Deployment 롤아웃 상태
+type ClusterRollout struct {
+	ClusterID           string               `json:"clusterId"`
+	Revision            string               `json:"revision"`
+	Images              map[string]string    `json:"images"` // 컨테이너 이름 -> 이미지
+	Generation          int64                `json:"generation"`
+	ObservedGeneration  int64                `json:"observedGeneration"`
+	Replicas            int32                `json:"replicas"` // 원하는 replica 수
+	UpdatedReplicas     int32                `json:"updatedReplicas"`
+	ReadyReplicas       int32                `json:"readyReplicas"`
+	AvailableReplicas   int32                `json:"availableReplicas"`
+	UnavailableReplicas int32                `json:"unavailableReplicas"`
+	Progressing         *DeploymentCondition `json:"progressing,omitempty"`
+	Available           *DeploymentCondition `json:"available,omitempty"`
+	Phase               string               `json:"phase"`                 // progressing, complete, stalled
+	StartedAt           *time.Time           `json:"startedAt,omitempty"`   // 마지막 롤아웃 시작 시각 (추적 시작 이후)
+	CompletedAt         *time.Time           `json:"completedAt,omitempty"` // 마지막 롤아웃 완료 시각
+	UpdatedAt           time.Time            `json:"updatedAt"`
+
+	rolling bool // revision 변경으로 시작된 롤아웃 진행 중
+}
+
+// Rollout Deployment의 클러스터별 롤아웃 상태
+type Rollout struct {
+	Namespace  string           `json:"namespace"`
+	Name       string           `json:"name"`
+	Clusters   []ClusterRollout `json:"clusters"`
+	Missing    []string         `json:"missing"`    // Deployment가 없는 클러스터
+	Consistent bool             `json:"consistent"` // 모든 클러스터가 같은 이미지이고 롤아웃 완료
+}
+
+// RolloutTracker member 클러스터의 Deployment 롤아웃을 감시해 이벤트 기록
+type RolloutTracker struct {
+	clients    map[string]kubernetes.Interface // 클러스터 ID별 클라이언트
+	eventLog   *eventlog.EventLog
+	namespaces []string
+
+	mu          sync.RWMutex
+	deployments map[string]map[string]*ClusterRollout // [namespace/name][clusterID]
+	synced      map[string]bool                       // 초기 목록 동기화가 끝난 클러스터 (이전 상태는 이벤트 없이 기록)
+}
+
+// NewRolloutTrackerFromEnv 환경변수 기반 롤아웃 추적기 생성
+// ROLLOUT_NAMESPACES 기본값은 APP_NAMESPACE, tf-monitor ("*"이면 전체 네임스페이스)
+func NewRolloutTrackerFromEnv(mcm *MultiClusterMonitor, eventLog *eventlog.EventLog) *RolloutTracker {
+	clients := make(map[string]kubernetes.Interface, len(mcm.memberClusters))
+	for contextName, clientset := range mcm.memberClusters {
+		if id, ok := memberClusterIDs[contextName]; ok {
+			clients[id] = clientset
+		}
+	}
+
+	appNamespace := os.Getenv("APP_NAMESPACE")
+	if appNamespace == "" {
+		appNamespace = "default"
+	}
+	namespaces := splitList(getEnvDefault("ROLLOUT_NAMESPACES", appNamespace+",tf-monitor"))
+	for _, ns := range namespaces {
+		if ns == "*" {
+			namespaces = []string{metav1.NamespaceAll}
+			break
+		}
+	}
+
+	return NewRolloutTracker(clients, dedupStrings(namespaces), eventLog)
+}
+
+// NewRolloutTracker 클러스터 ID별 클라이언트로 롤아웃 추적기 생성
+func NewRolloutTracker(clients map[string]kubernetes.Interface, namespaces []string, eventLog *eventlog.EventLog) *RolloutTracker {
+	return &RolloutTracker{
+		clients:     clients,
+		eventLog:    eventLog,
+		namespaces:  namespaces,
+		deployments: make(map[string]map[string]*ClusterRollout),
+		synced:      make(map[string]bool),
+	}
+}
+
+// Run 클러스터/네임스페이스별 Deployment informer 실행 (블로킹)
+func (t *RolloutTracker) Run() {
+	if len(t.clients) == 0 {
+		log.Printf("[Rollout] No member cluster clients, rollout tracking disabled")
+		return
+	}
+
+	stop := make(chan struct{})
+
+	for clusterID, client := range t.clients {
+		syncs := make([]cache.InformerSynced, 0, len(t.namespaces))
+		for _, namespace := range t.namespaces {
+			factory := informers.NewSharedInformerFactoryWithOptions(client, 0, informers.WithNamespace(namespace))
+			informer := factory.Apps().V1().Deployments().Informer()
+
+			clusterID, namespace := clusterID, namespace
+			if err := informer.SetWatchErrorHandler(func(_ *cache.Reflector, err error) {
+				log.Printf("[Rollout] Watch error in %s/%s: %v", clusterID, displayNamespace(namespace), err)
+			}); err != nil {
+				log.Printf("[Rollout] Failed to set watch error handler: %v", err)
+			}
+
+			if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+				AddFunc: func(obj interface{}) {
+					if d, ok := obj.(*appsv1.Deployment); ok {
+						t.observe(clusterID, d)
+					}
+				},
+				UpdateFunc: func(_, newObj interface{}) {
+					if d, ok := newObj.(*appsv1.Deployment); ok {
+						t.observe(clusterID, d)
+					}
+				},
+				DeleteFunc: func(obj interface{}) {
+					if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+						obj = tombstone.Obj
+					}
+					if d, ok := obj.(*appsv1.Deployment); ok {
+						t.forget(clusterID, d)
+					}
+				},
+			}); err != nil {
+				log.Printf("[Rollout] Failed to register handler for %s/%s: %v", clusterID, displayNamespace(namespace), err)
+				continue
+			}
+
+			factory.Start(stop)
+			syncs = append(syncs, informer.HasSynced)
+		}
+
+		go func(clusterID string, syncs []cache.InformerSynced) {
+			if !cache.WaitForCacheSync(stop, syncs...) {
+				return
+			}
+			t.mu.Lock()
+			t.synced[clusterID] = true
+			t.mu.Unlock()
+			log.Printf("[Rollout] Tracking deployments in %s (%s)", clusterID, strings.Join(t.namespaceNames(), ", "))
+		}(clusterID, syncs)
+	}
+
+	<-stop
+}
+
+// observe Deployment 상태를 반영하고 롤아웃 시작/진행/완료/정체 이벤트 기록
+func (t *RolloutTracker) observe(clusterID string, d *appsv1.Deployment) {
+	current := clusterRolloutOf(clusterID, d)
+	key := d.Namespace + "/" + d.Name
+
+	t.mu.Lock()
+	clusters := t.deployments[key]
+	if clusters == nil {
+		clusters = make(map[string]*ClusterRollout)
+		t.deployments[key] = clusters
+	}
+	previous := clusters[clusterID]
+	synced := t.synced[clusterID]
+
+	var events []eventlog.Event
+	if previous == nil {
+		if synced && current.Revision != "" {
+			// 추적 시작 이후 새로 생긴 Deployment는 첫 롤아웃
+			// (revision이 아직 없으면 컨트롤러가 기록할 때 시작으로 처리)
+			startedAt := current.UpdatedAt
+			current.rolling = true
+			current.StartedAt = &startedAt
+			events = append(events, rolloutEvent(key, current, "started"))
+		}
+	} else {
+		current.rolling = previous.rolling
+		current.StartedAt = previous.StartedAt
+		current.CompletedAt = previous.CompletedAt
+		events = t.transitions(key, previous, &current)
+	}
+	clusters[clusterID] = &current
+	t.mu.Unlock()
+
+	for _, event := range events {
+		log.Printf("[Rollout] %s", event.Message)
+		t.eventLog.Record(event)
+	}
+}
+
+// transitions 이전 상태와 비교해 롤아웃 이벤트 생성 (current의 추적 필드 갱신)
+func (t *RolloutTracker) transitions(key string, previous *ClusterRollout, current *ClusterRollout) []eventlog.Event {
+	events := make([]eventlog.Event, 0)
+
+	// 새 ReplicaSet이 만들어지면(롤백 포함) 컨트롤러가 revision을 올림
+	if current.Revision != previous.Revision {
+		startedAt := current.UpdatedAt
+		current.rolling = true
+		current.StartedAt = &startedAt
+		current.CompletedAt = nil
+		events = append(events, rolloutEvent(key, *current, "started"))
+	}
+
+	switch {
+	case current.Phase == RolloutStalled && previous.Phase != RolloutStalled:
+		events = append(events, rolloutEvent(key, *current, "stalled"))
+	case current.Phase == RolloutComplete && (current.rolling || previous.Phase == RolloutStalled):
+		completedAt := current.UpdatedAt
+		current.rolling = false
+		current.CompletedAt = &completedAt
+		events = append(events, rolloutEvent(key, *current, "completed"))
+	case current.Phase == RolloutProgressing && current.rolling && len(events) == 0 &&
+		(current.UpdatedReplicas != previous.UpdatedReplicas || current.AvailableReplicas != previous.AvailableReplicas):
+		events = append(events, rolloutEvent(key, *current, "progressing"))
+	}
+	return events
+}
+
+// forget 삭제된 Deployment 상태 제거
+func (t *RolloutTracker) forget(clusterID string, d *appsv1.Deployment) {
+	key := d.Namespace + "/" + d.Name
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.deployments[key], clusterID)
+	if len(t.deployments[key]) == 0 {
+		delete(t.deployments, key)
+	}
+}
+
+// List 롤아웃 상태 목록 (namespace, name이 비어 있으면 전체)
+func (t *RolloutTracker) List(namespace, name string) []Rollout {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	rollouts := make([]Rollout, 0, len(t.deployments))
+	for key, clusters := range t.deployments {
+		ns, n, _ := strings.Cut(key, "/")
+		if (namespace != "" && ns != namespace) || (name != "" && n != name) {
+			continue
+		}
+		rollouts = append(rollouts, t.rolloutOf(ns, n, clusters))
+	}
+
+	sort.Slice(rollouts, func(i, j int) bool {
+		if rollouts[i].Namespace != rollouts[j].Namespace {
+			return rollouts[i].Namespace < rollouts[j].Namespace
+		}
+		return rollouts[i].Name < rollouts[j].Name
+	})
+	return rollouts
+}
+
+// rolloutOf 클러스터별 상태를 하나의 롤아웃 상태로 합침 (t.mu 보유 상태에서 호출)
+func (t *RolloutTracker) rolloutOf(namespace, name string, clusters map[string]*ClusterRollout) Rollout {
+	rollout := Rollout{
+		Namespace:  namespace,
+		Name:       name,
+		Clusters:   make([]ClusterRollout, 0, len(clusters)),
+		Missing:    make([]string, 0),
+		Consistent: true,
+	}
+
+	for clusterID := range t.clients {
+		cr, ok := clusters[clusterID]
+		if !ok {
+			rollout.Missing = append(rollout.Missing, clusterID)
+			rollout.Consistent = false
+			continue
+		}
+		rollout.Clusters = append(rollout.Clusters, *cr)
+	}
+	sort.Strings(rollout.Missing)
+	sort.Slice(rollout.Clusters, func(i, j int) bool {
+		return rollout.Clusters[i].ClusterID < rollout.Clusters[j].ClusterID
+	})
+
+	for _, cr := range rollout.Clusters {
+		if cr.Phase != RolloutComplete || !sameImages(cr.Images, rollout.Clusters[0].Images) {
+			rollout.Consistent = false
+		}
+	}
+	return rollout
+}
+
+// namespaceNames 로그용 네임스페이스 목록
+func (t *RolloutTracker) namespaceNames() []string {
+	names := make([]string, 0, len(t.namespaces))
+	for _, ns := range t.namespaces {
+		names = append(names, displayNamespace(ns))
+	}
+	return names
+}
+
+// clusterRolloutOf Deployment에서 롤아웃 상태 추출
+func clusterRolloutOf(clusterID string, d *appsv1.Deployment) ClusterRollout {
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+
+	cr := ClusterRollout{
+		ClusterID:           clusterID,
+		Revision:            d.Annotations[revisionAnnotation],
+		Images:              make(map[string]string, len(d.Spec.Template.Spec.Containers)),
+		Generation:          d.Generation,
+		ObservedGeneration:  d.Status.ObservedGeneration,
+		Replicas:            replicas,
+		UpdatedReplicas:     d.Status.UpdatedReplicas,
+		ReadyReplicas:       d.Status.ReadyReplicas,
+		AvailableReplicas:   d.Status.AvailableReplicas,
+		UnavailableReplicas: d.Status.UnavailableReplicas,
+		UpdatedAt:           time.Now(),
+	}
+	for _, c := range d.Spec.Template.Spec.Containers {
+		cr.Images[c.Name] = c.Image
+	}
+
+	for _, cond := range d.Status.Conditions {
+		condition := &DeploymentCondition{
+			Status:         string(cond.Status),
+			Reason:         cond.Reason,
+			Message:        cond.Message,
+			LastUpdateTime: cond.LastUpdateTime.Time,
+		}
+		switch cond.Type {
+		case appsv1.DeploymentProgressing:
+			cr.Progressing = condition
+		case appsv1.DeploymentAvailable:
+			cr.Available = condition
+		}
+	}
+
+	cr.Phase = rolloutPhase(d, replicas, cr.Progressing)
+	return cr
+}
+
+// rolloutPhase kubectl rollout status와 같은 기준으로 롤아웃 단계 판단
+func rolloutPhase(d *appsv1.Deployment, replicas int32, progressing *DeploymentCondition) string {
+	switch {
+	case progressing != nil && progressing.Reason == "ProgressDeadlineExceeded":
+		return RolloutStalled
+	case d.Generation > d.Status.ObservedGeneration:
+		return RolloutProgressing
+	case d.Status.UpdatedReplicas < replicas:
+		return RolloutProgressing
+	case d.Status.Replicas > d.Status.UpdatedReplicas:
+		return RolloutProgressing
+	case d.Status.AvailableReplicas < d.Status.UpdatedReplicas:
+		return RolloutProgressing
+	default:
+		return RolloutComplete
+	}
+}
+
+// rolloutEvent 롤아웃 이벤트 생성 (kind: started, progressing, completed, stalled)
+func rolloutEvent(key string, cr ClusterRollout, kind string) eventlog.Event {
+	subject := fmt.Sprintf("Deployment %s in %s", key, cr.ClusterID)
+	event := eventlog.Event{
+		Type:      "info",
+		Severity:  eventlog.SeverityInfo,
+		Source:    "rollout",
+		ClusterID: cr.ClusterID,
+		Attributes: map[string]string{
+			"rollout":           kind,
+			"revision":          cr.Revision,
+			"images":            formatImages(cr.Images),
+			"updatedReplicas":   fmt.Sprintf("%d", cr.UpdatedReplicas),
+			"availableReplicas": fmt.Sprintf("%d", cr.AvailableReplicas),
+			"replicas":          fmt.Sprintf("%d", cr.Replicas),
+		},
+	}
+	ns, name, _ := strings.Cut(key, "/")
+	event.InvolvedObject = &eventlog.ObjectReference{Kind: "Deployment", Namespace: ns, Name: name}
+
+	switch kind {
+	case "started":
+		event.Type = "auto"
+		event.Message = fmt.Sprintf("🚀 %s rollout started (revision %s, %s)", subject, cr.Revision, formatImages(cr.Images))
+	case "progressing":
+		event.Message = fmt.Sprintf("⏳ %s rollout progressing - %d/%d updated, %d available",
+			subject, cr.UpdatedReplicas, cr.Replicas, cr.AvailableReplicas)
+	case "completed":
+		event.Type = "success"
+		event.Message = fmt.Sprintf("✅ %s rollout completed (revision %s, %d/%d available)",
+			subject, cr.Revision, cr.AvailableReplicas, cr.Replicas)
+	case "stalled":
+		event.Type = "critical"
+		event.Severity = eventlog.SeverityCritical
+		message := "progress deadline exceeded"
+		if cr.Progressing != nil && cr.Progressing.Message != "" {
+			message = cr.Progressing.Message
+		}
+		event.Message = fmt.Sprintf("🔴 %s rollout STALLED (revision %s) - %s", subject, cr.Revision, message)
+	}
+	return event
+}
+
+// sameImages 두 컨테이너 이미지 목록이 같은지
+func sameImages(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, image := range a {
+		if b[name] != image {
+			return false
+		}
+	}
+	return true
+}
+
+// formatImages "app=repo/app:v1.4" 형식 (컨테이너 이름순)
+func formatImages(images map[string]string) string {
+	names := make([]string, 0, len(images))
+	for name := range images {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	items := make([]string, 0, len(names))
+	for _, name := range names {
+		items = append(items, name+"="+images[name])
+	}
+	return strings.Join(items, ",")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,6 +31,29 @@ type ServiceNode struct {
 	Replicas      int32  `json:"replicas"`      // desired replicas
 	ReadyReplicas int32  `json:"readyReplicas"` // ready replicas
 	Status        string `json:"status"`        // healthy, degraded, failed
+
+	Placement *WorkloadPlacement `json:"placement,omitempty"` // Karmada 전파 정보 (조회 가능할 때만)
+}
+
+// WorkloadPlacement Karmada 전파 정보 요약
+type WorkloadPlacement struct {
+	Policy            string             `json:"policy"`                     // PropagationPolicy namespace/name 또는 ClusterPropagationPolicy name
+	ReplicaScheduling string             `json:"replicaScheduling"`          // Duplicated, Divided/Weighted, Divided/Aggregated
+	Clusters          []ScheduledCluster `json:"clusters"`                   // 스케줄러가 선택한 클러스터
+	Overrides         []string           `json:"overrides"`                  // 적용되는 OverridePolicy namespace/name
+	Scheduled         bool               `json:"scheduled"`                  // ResourceBinding Scheduled 조건
+	FullyApplied      bool               `json:"fullyApplied"`               // ResourceBinding FullyApplied 조건
+	EligibleClusters  []string           `json:"eligibleClusters,omitempty"` // clusterAffinity가 이름으로 허용한 클러스터 (없으면 제한 없음)
+	ExcludedClusters  []string           `json:"excludedClusters,omitempty"` // clusterAffinity exclude
+	EvictionBlocked   bool               `json:"evictionBlocked,omitempty"`  // 클러스터 장애 taint를 무기한 허용해 재스케줄되지 않음
+}
+
+// ScheduledCluster 스케줄된 클러스터와 replica 분배, Work 적용 상태
+type ScheduledCluster struct {
+	Name     string `json:"name"` // Karmada 클러스터 이름
+	Replicas int32  `json:"replicas"`
+	Applied  bool   `json:"applied"`
+	Health   string `json:"health,omitempty"` // Healthy, Unhealthy, Unknown
 }
 
 // ServiceEdge 서비스 간 연결 정보
@@ -48,7 +72,9 @@ type ServiceGraph struct {
 
 // TrafficMonitor 트래픽 모니터링
 type TrafficMonitor struct {
-	clientsets map[string]*kubernetes.Clientset
+	clientsets      map[string]*kubernetes.Clientset
+	mu              sync.RWMutex
+	placementSource PlacementSource // Karmada 전파 정보 (없으면 생략)
 }
 
 // NewTrafficMonitor 새 트래픽 모니터 생성
@@ -120,6 +146,7 @@ func (tm *TrafficMonitor) GetServiceGraph(deploymentName, namespace string) (*Se
 				Replicas:      replicas,
 				ReadyReplicas: deployment.Status.ReadyReplicas,
 				Status:        status,
+				Placement:     tm.placement("Deployment", deployment.Namespace, deployment.Name),
 			}
 			graph.Nodes = append(graph.Nodes, node)
 		}
@@ -131,6 +158,29 @@ func (tm *TrafficMonitor) GetServiceGraph(deploymentName, namespace string) (*Se
 	return graph, nil
 }
 
+// setPlacementSource Karmada 전파 정보 제공자 설정
+func (tm *TrafficMonitor) setPlacementSource(source PlacementSource) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	tm.placementSource = source
+}
+
+// placement 워크로드의 Karmada 전파 정보 (제공자가 없거나 정보가 없으면 nil)
+func (tm *TrafficMonitor) placement(kind, namespace, name string) *WorkloadPlacement {
+	tm.mu.RLock()
+	source := tm.placementSource
+	tm.mu.RUnlock()
+
+	if source == nil {
+		return nil
+	}
+	placement, ok := source.WorkloadPlacement(kind, namespace, name)
+	if !ok {
+		return nil
+	}
+	return &placement
+}
+
 // detectCrossClusterTraffic East-West Gateway를 통한 크로스 클러스터 트래픽 감지
 func (tm *TrafficMonitor) detectCrossClusterTraffic(graph *ServiceGraph, deploymentName, namespace string) {
 	// Member1과 Member2 클러스터 간 연결 확인
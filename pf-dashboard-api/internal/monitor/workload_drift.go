@@ -0,0 +1,489 @@
+package monitor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// 비교 결과에서 리소스가 없는 클러스터의 값
+const absentValue = "<absent>"
+
+// maxDriftValueLength ConfigMap 값 등 긴 값은 잘라서 표시
+const maxDriftValueLength = 120
+
+// FieldDiff 클러스터마다 값이 다른 필드 하나
+type FieldDiff struct {
+	Field  string            `json:"field"`  // exists, replicas, containers[app].image, labels.tier ...
+	Values map[string]string `json:"values"` // 클러스터 ID -> 값 (없으면 <absent>)
+}
+
+// WorkloadDrift 워크로드 하나의 클러스터 간 차이
+type WorkloadDrift struct {
+	Kind      string      `json:"kind"` // Deployment, StatefulSet, ConfigMap, Service
+	Namespace string      `json:"namespace"`
+	Name      string      `json:"name"`
+	Diffs     []FieldDiff `json:"diffs"`
+}
+
+// WorkloadDriftReport 한 번의 클러스터 간 비교 결과
+type WorkloadDriftReport struct {
+	Time      time.Time       `json:"time"`
+	Clusters  []string        `json:"clusters"`
+	Compared  int             `json:"compared"` // 비교한 워크로드 수
+	InSync    bool            `json:"inSync"`
+	Workloads []WorkloadDrift `json:"workloads"`
+	Errors    []string        `json:"errors,omitempty"` // 조회 실패 (해당 클러스터/종류는 비교에서 제외)
+}
+
+// workloadObject 비교용으로 펼친 리소스
+type workloadObject struct {
+	kind      string
+	namespace string
+	name      string
+	fields    map[string]string
+}
+
+// WorkloadDriftDetector member 클러스터 간 같은 이름의 워크로드를 주기적으로 비교
+type WorkloadDriftDetector struct {
+	clients    map[string]kubernetes.Interface // 클러스터 ID별 클라이언트
+	eventLog   *eventlog.EventLog
+	namespaces []string
+	interval   time.Duration
+	ignore     []string // 비교하지 않는 필드 (접두사 일치)
+
+	runMu    sync.Mutex // Detect 동시 실행 방지
+	mu       sync.RWMutex
+	latest   *WorkloadDriftReport
+	reported map[string]map[string]bool // 이미 이벤트로 보고한 워크로드별 drift 필드
+}
+
+// NewWorkloadDriftDetectorFromEnv 환경변수 기반 워크로드 drift 감지기 생성
+// WORKLOAD_DRIFT_NAMESPACES 기본값은 APP_NAMESPACE, tf-monitor
+// WORKLOAD_DRIFT_IGNORE="replicas,labels.app.kubernetes.io/version" 형식으로 비교 제외 필드 지정
+func NewWorkloadDriftDetectorFromEnv(mcm *MultiClusterMonitor, eventLog *eventlog.EventLog) *WorkloadDriftDetector {
+	clients := make(map[string]kubernetes.Interface, len(mcm.memberClusters))
+	for contextName, clientset := range mcm.memberClusters {
+		if id, ok := memberClusterIDs[contextName]; ok {
+			clients[id] = clientset
+		}
+	}
+
+	appNamespace := os.Getenv("APP_NAMESPACE")
+	if appNamespace == "" {
+		appNamespace = "default"
+	}
+
+	return &WorkloadDriftDetector{
+		clients:    clients,
+		eventLog:   eventLog,
+		namespaces: dedupStrings(splitList(getEnvDefault("WORKLOAD_DRIFT_NAMESPACES", appNamespace+",tf-monitor"))),
+		interval:   getDurationEnv("WORKLOAD_DRIFT_INTERVAL", 60*time.Second),
+		ignore:     splitList(os.Getenv("WORKLOAD_DRIFT_IGNORE")),
+		reported:   make(map[string]map[string]bool),
+	}
+}
+
+// Run 주기적으로 비교 (블로킹)
+func (d *WorkloadDriftDetector) Run() {
+	if len(d.clients) < 2 || d.interval <= 0 {
+		log.Printf("[WorkloadDrift] Disabled (clusters: %d, interval: %s)", len(d.clients), d.interval)
+		return
+	}
+
+	log.Printf("[WorkloadDrift] Started (namespaces: %s, interval: %s)", strings.Join(d.namespaces, ", "), d.interval)
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	d.Detect(context.Background())
+	for range ticker.C {
+		d.Detect(context.Background())
+	}
+}
+
+// Latest 마지막 비교 결과 (아직 없으면 nil)
+func (d *WorkloadDriftDetector) Latest() *WorkloadDriftReport {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.latest
+}
+
+// Detect 모든 클러스터의 워크로드를 조회해 비교하고 새 drift를 이벤트로 기록
+func (d *WorkloadDriftDetector) Detect(ctx context.Context) *WorkloadDriftReport {
+	d.runMu.Lock()
+	defer d.runMu.Unlock()
+
+	report := &WorkloadDriftReport{
+		Time:      time.Now(),
+		Clusters:  make([]string, 0, len(d.clients)),
+		Workloads: []WorkloadDrift{},
+	}
+	for clusterID := range d.clients {
+		report.Clusters = append(report.Clusters, clusterID)
+	}
+	sort.Strings(report.Clusters)
+
+	// [kind/namespace][clusterID] 조회 성공 여부와 [kind/namespace/name][clusterID] 리소스
+	listed := make(map[string]map[string]bool)
+	objects := make(map[string]map[string]workloadObject)
+
+	for _, clusterID := range report.Clusters {
+		client := d.clients[clusterID]
+		for _, namespace := range d.namespaces {
+			for kind, list := range workloadListers {
+				listCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+				items, err := list(listCtx, client, namespace)
+				cancel()
+				if err != nil {
+					report.Errors = append(report.Errors, fmt.Sprintf("%s: list %s in %s: %v", clusterID, kind, namespace, err))
+					continue
+				}
+
+				scope := kind + "/" + namespace
+				if listed[scope] == nil {
+					listed[scope] = make(map[string]bool)
+				}
+				listed[scope][clusterID] = true
+
+				for _, obj := range items {
+					key := obj.kind + "/" + obj.namespace + "/" + obj.name
+					if objects[key] == nil {
+						objects[key] = make(map[string]workloadObject)
+					}
+					objects[key][clusterID] = obj
+				}
+			}
+		}
+	}
+	sort.Strings(report.Errors)
+
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		kind, rest, _ := strings.Cut(key, "/")
+		namespace, name, _ := strings.Cut(rest, "/")
+
+		// 조회에 성공한 클러스터끼리만 비교
+		clusters := make([]string, 0, len(report.Clusters))
+		for _, clusterID := range report.Clusters {
+			if listed[kind+"/"+namespace][clusterID] {
+				clusters = append(clusters, clusterID)
+			}
+		}
+		if len(clusters) < 2 {
+			continue
+		}
+
+		report.Compared++
+		if diffs := d.compare(objects[key], clusters); len(diffs) > 0 {
+			report.Workloads = append(report.Workloads, WorkloadDrift{Kind: kind, Namespace: namespace, Name: name, Diffs: diffs})
+		}
+	}
+
+	// 두 개 이상 클러스터에서 조회에 성공한 kind/namespace
+	compared := make(map[string]bool, len(listed))
+	for scope, clusters := range listed {
+		compared[scope] = len(clusters) >= 2
+	}
+
+	report.InSync = len(report.Workloads) == 0
+	d.reportEvents(report, compared)
+
+	d.mu.Lock()
+	d.latest = report
+	d.mu.Unlock()
+	return report
+}
+
+// compare 클러스터별 필드 값을 비교해 다른 필드 목록 반환
+func (d *WorkloadDriftDetector) compare(byCluster map[string]workloadObject, clusters []string) []FieldDiff {
+	fields := make(map[string]bool)
+	for _, obj := range byCluster {
+		for field := range obj.fields {
+			fields[field] = true
+		}
+	}
+
+	names := make([]string, 0, len(fields)+1)
+	names = append(names, "exists")
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names[1:])
+
+	diffs := make([]FieldDiff, 0)
+	for _, field := range names {
+		if d.ignored(field) {
+			continue
+		}
+
+		values := make(map[string]string, len(clusters))
+		distinct := make(map[string]bool)
+		for _, clusterID := range clusters {
+			obj, ok := byCluster[clusterID]
+			value := absentValue
+			switch {
+			case field == "exists" && ok:
+				value = "true"
+			case field == "exists":
+				value = "false"
+			case !ok:
+				// 리소스가 없는 클러스터는 exists 차이로만 보고
+				continue
+			default:
+				if v, has := obj.fields[field]; has {
+					value = v
+				}
+			}
+			values[clusterID] = value
+			distinct[value] = true
+		}
+
+		if len(distinct) > 1 {
+			diffs = append(diffs, FieldDiff{Field: field, Values: values})
+		}
+	}
+	return diffs
+}
+
+// ignored 비교 제외 필드인지
+func (d *WorkloadDriftDetector) ignored(field string) bool {
+	for _, prefix := range d.ignore {
+		if field == prefix || strings.HasPrefix(field, prefix+".") || strings.HasPrefix(field, prefix+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// reportEvents 새로 발견된 drift와 일치 복귀를 워크로드별 이벤트로 기록
+// compared는 이번에 비교한 kind/namespace (조회 실패로 비교하지 못한 워크로드는 보고 상태 유지)
+func (d *WorkloadDriftDetector) reportEvents(report *WorkloadDriftReport, compared map[string]bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	current := make(map[string]map[string]bool, len(report.Workloads))
+	for _, w := range report.Workloads {
+		key := w.Kind + "/" + w.Namespace + "/" + w.Name
+		current[key] = make(map[string]bool, len(w.Diffs))
+
+		newFields := make([]string, 0)
+		for _, diff := range w.Diffs {
+			current[key][diff.Field] = true
+			if !d.reported[key][diff.Field] {
+				newFields = append(newFields, diff.Field)
+			}
+		}
+		if len(newFields) == 0 {
+			continue
+		}
+
+		message := fmt.Sprintf("⚠️ %s %s/%s differs between %s: %s",
+			w.Kind, w.Namespace, w.Name, strings.Join(report.Clusters, ", "), strings.Join(newFields, ", "))
+		log.Printf("[WorkloadDrift] %s", message)
+		d.eventLog.Record(eventlog.Event{
+			Type:           "info",
+			Severity:       eventlog.SeverityWarning,
+			Message:        message,
+			Source:         "workload-drift",
+			InvolvedObject: &eventlog.ObjectReference{Kind: w.Kind, Namespace: w.Namespace, Name: w.Name},
+			Attributes: map[string]string{
+				"fields": strings.Join(newFields, ","),
+				"diffs":  fmt.Sprintf("%d", len(w.Diffs)),
+			},
+		})
+	}
+
+	// 이전에 drift가 있었지만 이번에 비교했고 차이가 없는 (또는 모든 클러스터에서 삭제된) 워크로드
+	for key, fields := range d.reported {
+		if current[key] != nil {
+			continue
+		}
+		kind, rest, _ := strings.Cut(key, "/")
+		namespace, name, _ := strings.Cut(rest, "/")
+		if !compared[kind+"/"+namespace] {
+			current[key] = fields
+			continue
+		}
+		d.eventLog.Record(eventlog.Event{
+			Type:           "success",
+			Message:        fmt.Sprintf("✅ %s %s/%s is consistent across %s", kind, namespace, name, strings.Join(report.Clusters, ", ")),
+			Source:         "workload-drift",
+			InvolvedObject: &eventlog.ObjectReference{Kind: kind, Namespace: namespace, Name: name},
+		})
+	}
+	d.reported = current
+}
+
+// workloadListers 종류별 리소스 조회 후 비교 필드로 펼침
+var workloadListers = map[string]func(ctx context.Context, client kubernetes.Interface, namespace string) ([]workloadObject, error){
+	"Deployment": func(ctx context.Context, client kubernetes.Interface, namespace string) ([]workloadObject, error) {
+		list, err := client.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		objects := make([]workloadObject, 0, len(list.Items))
+		for _, item := range list.Items {
+			fields := podTemplateFields(item.Spec.Template.Spec)
+			fields["replicas"] = replicasValue(item.Spec.Replicas)
+			addLabelFields(fields, item.Labels)
+			objects = append(objects, workloadObject{kind: "Deployment", namespace: item.Namespace, name: item.Name, fields: fields})
+		}
+		return objects, nil
+	},
+	"StatefulSet": func(ctx context.Context, client kubernetes.Interface, namespace string) ([]workloadObject, error) {
+		list, err := client.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		objects := make([]workloadObject, 0, len(list.Items))
+		for _, item := range list.Items {
+			fields := podTemplateFields(item.Spec.Template.Spec)
+			fields["replicas"] = replicasValue(item.Spec.Replicas)
+			addLabelFields(fields, item.Labels)
+			objects = append(objects, workloadObject{kind: "StatefulSet", namespace: item.Namespace, name: item.Name, fields: fields})
+		}
+		return objects, nil
+	},
+	"ConfigMap": func(ctx context.Context, client kubernetes.Interface, namespace string) ([]workloadObject, error) {
+		list, err := client.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		objects := make([]workloadObject, 0, len(list.Items))
+		for _, item := range list.Items {
+			// 클러스터마다 자동 생성되는 CA 번들은 비교하지 않음
+			if item.Name == "kube-root-ca.crt" {
+				continue
+			}
+			fields := make(map[string]string)
+			for k, v := range item.Data {
+				fields["data."+k] = truncateValue(v)
+			}
+			for k, v := range item.BinaryData {
+				sum := sha256.Sum256(v)
+				fields["binaryData."+k] = "sha256:" + hex.EncodeToString(sum[:8])
+			}
+			addLabelFields(fields, item.Labels)
+			objects = append(objects, workloadObject{kind: "ConfigMap", namespace: item.Namespace, name: item.Name, fields: fields})
+		}
+		return objects, nil
+	},
+	"Service": func(ctx context.Context, client kubernetes.Interface, namespace string) ([]workloadObject, error) {
+		list, err := client.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		objects := make([]workloadObject, 0, len(list.Items))
+		for _, item := range list.Items {
+			// clusterIP, nodePort는 클러스터마다 다르게 할당되므로 비교하지 않음
+			fields := map[string]string{"type": string(item.Spec.Type)}
+			for i, port := range item.Spec.Ports {
+				name := port.Name
+				if name == "" {
+					name = fmt.Sprintf("%d", i)
+				}
+				fields["ports["+name+"]"] = fmt.Sprintf("%d->%s/%s", port.Port, port.TargetPort.String(), port.Protocol)
+			}
+			for k, v := range item.Spec.Selector {
+				fields["selector."+k] = v
+			}
+			addLabelFields(fields, item.Labels)
+			objects = append(objects, workloadObject{kind: "Service", namespace: item.Namespace, name: item.Name, fields: fields})
+		}
+		return objects, nil
+	},
+}
+
+// podTemplateFields 컨테이너 이미지, 환경변수, 리소스 필드
+func podTemplateFields(spec corev1.PodSpec) map[string]string {
+	fields := make(map[string]string)
+	for _, c := range spec.Containers {
+		prefix := "containers[" + c.Name + "]"
+		fields[prefix+".image"] = c.Image
+
+		for _, env := range c.Env {
+			fields[prefix+".env."+env.Name] = envValue(env)
+		}
+		for i, from := range c.EnvFrom {
+			switch {
+			case from.ConfigMapRef != nil:
+				fields[fmt.Sprintf("%s.envFrom[%d]", prefix, i)] = "configMap:" + from.ConfigMapRef.Name
+			case from.SecretRef != nil:
+				fields[fmt.Sprintf("%s.envFrom[%d]", prefix, i)] = "secret:" + from.SecretRef.Name
+			}
+		}
+
+		for name, q := range c.Resources.Requests {
+			fields[prefix+".resources.requests."+string(name)] = q.String()
+		}
+		for name, q := range c.Resources.Limits {
+			fields[prefix+".resources.limits."+string(name)] = q.String()
+		}
+	}
+	return fields
+}
+
+// envValue 환경변수 값 (참조는 참조 대상으로 표시)
+func envValue(env corev1.EnvVar) string {
+	from := env.ValueFrom
+	switch {
+	case from == nil:
+		return truncateValue(env.Value)
+	case from.ConfigMapKeyRef != nil:
+		return "configMapKeyRef:" + from.ConfigMapKeyRef.Name + "/" + from.ConfigMapKeyRef.Key
+	case from.SecretKeyRef != nil:
+		return "secretKeyRef:" + from.SecretKeyRef.Name + "/" + from.SecretKeyRef.Key
+	case from.FieldRef != nil:
+		return "fieldRef:" + from.FieldRef.FieldPath
+	case from.ResourceFieldRef != nil:
+		return "resourceFieldRef:" + from.ResourceFieldRef.Resource
+	default:
+		return "valueFrom"
+	}
+}
+
+// addLabelFields 메타데이터 라벨 필드 추가 (Karmada가 클러스터마다 붙이는 라벨 제외)
+func addLabelFields(fields map[string]string, labels map[string]string) {
+	for k, v := range labels {
+		if strings.Contains(k, "karmada.io/") {
+			continue
+		}
+		fields["labels."+k] = v
+	}
+}
+
+// replicasValue replica 수 (지정하지 않으면 기본값 1)
+func replicasValue(replicas *int32) string {
+	if replicas == nil {
+		return "1"
+	}
+	return fmt.Sprintf("%d", *replicas)
+}
+
+// truncateValue 긴 값은 앞부분과 해시로 표시
+func truncateValue(value string) string {
+	if len(value) <= maxDriftValueLength {
+		return value
+	}
+	sum := sha256.Sum256([]byte(value))
+	return value[:maxDriftValueLength] + "… (sha256:" + hex.EncodeToString(sum[:8]) + ")"
+}
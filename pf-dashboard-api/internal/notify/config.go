@@ -0,0 +1,224 @@
+package notify
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	"sigs.k8s.io/yaml"
+)
+
+// 수신자 종류
+const (
+	TypeWebhook    = "webhook"    // 범용 JSON 웹훅
+	TypeSlack      = "slack"      // Slack incoming webhook
+	TypeMattermost = "mattermost" // Mattermost incoming webhook (Slack 호환)
+)
+
+// Config 알림 설정 파일 (NOTIFY_CONFIG)
+// 값의 ${VAR}는 환경변수로 치환
+type Config struct {
+	Receivers []ReceiverConfig `json:"receivers"`
+	Routes    []Route          `json:"routes"`
+}
+
+// ReceiverConfig 알림 수신자
+type ReceiverConfig struct {
+	Name      string            `json:"name"`
+	Type      string            `json:"type"` // webhook, slack, mattermost
+	URL       string            `json:"url"`
+	Secret    string            `json:"secret,omitempty"`    // HMAC-SHA256 서명 키 (비어 있으면 서명하지 않음)
+	Template  string            `json:"template,omitempty"`  // 메시지 템플릿 (Go text/template, 데이터는 이벤트)
+	Headers   map[string]string `json:"headers,omitempty"`   // 추가 HTTP 헤더
+	Channel   string            `json:"channel,omitempty"`   // slack/mattermost 채널 재지정
+	Username  string            `json:"username,omitempty"`  // slack/mattermost 표시 이름
+	RateLimit int               `json:"rateLimit,omitempty"` // 분당 최대 전송 수 (0이면 NOTIFY_RATE_LIMIT)
+}
+
+// Route 이벤트를 수신자로 보내는 규칙
+// 조건 목록이 비어 있으면 해당 조건은 모두 허용하고, 일치하는 모든 규칙의 수신자에게 전송
+type Route struct {
+	Name       string   `json:"name,omitempty"`
+	Receivers  []string `json:"receivers"`
+	Severities []string `json:"severities,omitempty"`
+	Clusters   []string `json:"clusters,omitempty"`
+	Types      []string `json:"types,omitempty"`
+	Sources    []string `json:"sources,omitempty"`
+}
+
+// defaultTemplate 기본 메시지 템플릿
+const defaultTemplate = `[{{upper .Severity}}] {{if .ClusterID}}{{.ClusterID}}: {{end}}{{.Message}}`
+
+// templateFuncs 메시지 템플릿 함수
+var templateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"attr": func(e eventlog.Event, key string) string {
+		return e.Attributes[key]
+	},
+}
+
+// LoadConfig 알림 설정 파일 로드 및 검증
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notify config: %w", err)
+	}
+
+	var config Config
+	if err := yaml.UnmarshalStrict([]byte(os.ExpandEnv(string(data))), &config); err != nil {
+		return nil, fmt.Errorf("failed to parse notify config: %w", err)
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// configFromEnv 설정 파일 없이 환경변수로 수신자 하나씩 구성
+// NOTIFY_WEBHOOK_URL, NOTIFY_SLACK_URL, NOTIFY_MATTERMOST_URL 중 설정된 것을 NOTIFY_SEVERITIES 이벤트에 사용
+func configFromEnv() *Config {
+	config := &Config{}
+	receivers := []ReceiverConfig{
+		{Name: TypeWebhook, Type: TypeWebhook, URL: os.Getenv("NOTIFY_WEBHOOK_URL"), Secret: os.Getenv("NOTIFY_WEBHOOK_SECRET")},
+		{Name: TypeSlack, Type: TypeSlack, URL: os.Getenv("NOTIFY_SLACK_URL")},
+		{Name: TypeMattermost, Type: TypeMattermost, URL: os.Getenv("NOTIFY_MATTERMOST_URL")},
+	}
+
+	route := Route{Name: "default", Severities: splitList(getEnvDefault("NOTIFY_SEVERITIES", eventlog.SeverityCritical))}
+	for _, r := range receivers {
+		if r.URL == "" {
+			continue
+		}
+		config.Receivers = append(config.Receivers, r)
+		route.Receivers = append(route.Receivers, r.Name)
+	}
+	if len(route.Receivers) > 0 {
+		config.Routes = []Route{route}
+	}
+	return config
+}
+
+// validate 수신자 이름/종류/템플릿과 규칙의 수신자 참조 확인
+func (c *Config) validate() error {
+	names := make(map[string]bool, len(c.Receivers))
+	for _, r := range c.Receivers {
+		if r.Name == "" || r.URL == "" {
+			return fmt.Errorf("notify receiver requires name and url")
+		}
+		if names[r.Name] {
+			return fmt.Errorf("duplicate notify receiver %q", r.Name)
+		}
+		names[r.Name] = true
+
+		switch r.Type {
+		case TypeWebhook, TypeSlack, TypeMattermost:
+		default:
+			return fmt.Errorf("notify receiver %s: unknown type %q (webhook, slack, mattermost)", r.Name, r.Type)
+		}
+		if _, err := parseTemplate(r); err != nil {
+			return err
+		}
+	}
+
+	for i, route := range c.Routes {
+		if len(route.Receivers) == 0 {
+			return fmt.Errorf("notify route #%d has no receivers", i+1)
+		}
+		for _, name := range route.Receivers {
+			if !names[name] {
+				return fmt.Errorf("notify route #%d references unknown receiver %q", i+1, name)
+			}
+		}
+	}
+	return nil
+}
+
+// parseTemplate 수신자 메시지 템플릿 파싱 (비어 있으면 기본 템플릿)
+func parseTemplate(r ReceiverConfig) (*template.Template, error) {
+	text := r.Template
+	if text == "" {
+		text = defaultTemplate
+	}
+
+	tmpl, err := template.New(r.Name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("notify receiver %s: invalid template: %w", r.Name, err)
+	}
+	return tmpl, nil
+}
+
+// matches 이벤트가 규칙 조건을 만족하는지
+func (r Route) matches(e eventlog.Event) bool {
+	return matchList(r.Severities, e.Severity) &&
+		matchList(r.Clusters, e.ClusterID) &&
+		matchList(r.Types, e.Type) &&
+		matchList(r.Sources, e.Source)
+}
+
+// matchList 목록이 비어 있거나 값이 포함되어 있는지 (대소문자 무시)
+func matchList(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// getEnvDefault 환경변수 조회 (없으면 기본값)
+func getEnvDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// getDurationEnv 기간 환경변수 조회
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Warning: invalid %s %q, using %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return d
+}
+
+// getIntEnv 정수 환경변수 조회
+func getIntEnv(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Warning: invalid %s %q, using %d", key, value, defaultValue)
+		return defaultValue
+	}
+	return n
+}
+
+// splitList 쉼표로 구분된 환경변수 값 파싱
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,441 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+)
+
+// maxRetryDelay 재시도 간격 상한
+const maxRetryDelay = 30 * time.Second
+
+// lastEventTTL 리소스별 마지막 이벤트를 기억하는 기간 (중복 제거 기간보다 길게)
+const lastEventTTL = time.Hour
+
+// ReceiverStatus 수신자별 전송 현황
+type ReceiverStatus struct {
+	Name        string     `json:"name"`
+	Type        string     `json:"type"`
+	Endpoint    string     `json:"endpoint"` // scheme://host (경로의 토큰은 숨김)
+	Signed      bool       `json:"signed"`
+	RateLimit   int        `json:"rateLimit"` // 분당 최대 전송 수 (0이면 제한 없음)
+	Sent        int        `json:"sent"`
+	Failed      int        `json:"failed"`
+	RateLimited int        `json:"rateLimited"` // 전송 한도 초과로 버린 알림 수
+	Dropped     int        `json:"dropped"`     // 대기열이 가득 차 버린 알림 수
+	LastSentAt  *time.Time `json:"lastSentAt,omitempty"`
+	LastError   string     `json:"lastError,omitempty"`
+}
+
+// Status 알림 설정과 수신자별 현황
+type Status struct {
+	Enabled   bool             `json:"enabled"`
+	Source    string           `json:"source"` // 설정 파일 경로 또는 env
+	Routes    []Route          `json:"routes"`
+	Receivers []ReceiverStatus `json:"receivers"`
+}
+
+// TestResult 테스트 알림 전송 결과
+type TestResult struct {
+	Receiver string `json:"receiver"`
+	Success  bool   `json:"success"`
+	Error    string `json:"error,omitempty"`
+}
+
+// receiver 수신자 하나의 전송 대기열과 현황
+type receiver struct {
+	config   ReceiverConfig
+	template *template.Template
+	limiter  *rateLimiter
+	queue    chan eventlog.Event
+
+	mu     sync.Mutex
+	status ReceiverStatus
+}
+
+// Notifier 이벤트를 규칙에 따라 외부 웹훅으로 전송
+type Notifier struct {
+	source     string
+	routes     []Route
+	receivers  []*receiver
+	byName     map[string]*receiver
+	client     *http.Client
+	maxRetries int
+	retryDelay time.Duration
+}
+
+// NewNotifierFromEnv 환경변수 기반 알림 전송기 생성
+// NOTIFY_CONFIG 파일이 있으면 사용하고, 없으면 NOTIFY_WEBHOOK_URL 등 단일 수신자 환경변수 사용
+func NewNotifierFromEnv() *Notifier {
+	n := &Notifier{
+		source:     "env",
+		byName:     make(map[string]*receiver),
+		client:     &http.Client{Timeout: getDurationEnv("NOTIFY_TIMEOUT", 10*time.Second)},
+		maxRetries: getIntEnv("NOTIFY_MAX_RETRIES", 3),
+		retryDelay: getDurationEnv("NOTIFY_RETRY_DELAY", time.Second),
+	}
+
+	config := configFromEnv()
+	if path := os.Getenv("NOTIFY_CONFIG"); path != "" {
+		loaded, err := LoadConfig(path)
+		if err != nil {
+			log.Printf("Warning: %v, notifications disabled", err)
+			return n
+		}
+		config = loaded
+		n.source = path
+	} else if err := config.validate(); err != nil {
+		log.Printf("Warning: %v, notifications disabled", err)
+		return n
+	}
+
+	defaultRateLimit := getIntEnv("NOTIFY_RATE_LIMIT", 30)
+	for _, rc := range config.Receivers {
+		tmpl, _ := parseTemplate(rc) // validate에서 확인됨
+		rateLimit := rc.RateLimit
+		if rateLimit == 0 {
+			rateLimit = defaultRateLimit
+		}
+
+		r := &receiver{
+			config:   rc,
+			template: tmpl,
+			limiter:  newRateLimiter(rateLimit),
+			queue:    make(chan eventlog.Event, 100),
+			status: ReceiverStatus{
+				Name:      rc.Name,
+				Type:      rc.Type,
+				Endpoint:  endpointOf(rc.URL),
+				Signed:    rc.Secret != "",
+				RateLimit: rateLimit,
+			},
+		}
+		n.receivers = append(n.receivers, r)
+		n.byName[rc.Name] = r
+	}
+	n.routes = config.Routes
+	return n
+}
+
+// Enabled 수신자가 설정되었는지
+func (n *Notifier) Enabled() bool {
+	return len(n.receivers) > 0 && len(n.routes) > 0
+}
+
+// Run 이벤트 로그 구독 채널을 받아 규칙에 맞는 이벤트 전송 (블로킹)
+func (n *Notifier) Run(events <-chan eventlog.Event) {
+	if !n.Enabled() {
+		log.Printf("[Notifier] Disabled (no receivers or routes configured)")
+		return
+	}
+
+	log.Printf("[Notifier] Started (%d receivers, %d routes, source: %s)", len(n.receivers), len(n.routes), n.source)
+	for _, r := range n.receivers {
+		go n.worker(r)
+	}
+
+	// 리소스별 마지막 이벤트 (ID와 마지막 발생 시각)
+	last := make(map[string]eventlog.Event)
+	for event := range events {
+		if n.repeated(last, event) {
+			continue
+		}
+		n.dispatch(event)
+	}
+}
+
+// repeated 직전에 받은 같은 리소스의 이벤트가 횟수만 갱신되어 다시 전달되었는지
+// 복구 등 다른 이벤트 뒤에 같은 ID로 다시 합쳐진 이벤트(DOWN → RECOVERED → DOWN)는 새로 알림
+func (n *Notifier) repeated(last map[string]eventlog.Event, event eventlog.Event) bool {
+	for key, e := range last {
+		if event.LastSeen.Sub(e.LastSeen) > lastEventTTL {
+			delete(last, key)
+		}
+	}
+
+	key := stateKey(event)
+	previous, ok := last[key]
+	last[key] = event
+	return event.Count > 1 && (!ok || previous.ID == event.ID)
+}
+
+// dispatch 일치하는 규칙의 수신자 대기열에 이벤트 추가 (수신자당 한 번)
+func (n *Notifier) dispatch(event eventlog.Event) {
+	queued := make(map[string]bool)
+	for _, route := range n.routes {
+		if !route.matches(event) {
+			continue
+		}
+		for _, name := range route.Receivers {
+			if queued[name] {
+				continue
+			}
+			queued[name] = true
+
+			r := n.byName[name]
+			select {
+			case r.queue <- event:
+			default:
+				log.Printf("[Notifier] Queue full for %s, dropping event #%d", name, event.ID)
+				r.record(func(s *ReceiverStatus) { s.Dropped++ })
+			}
+		}
+	}
+}
+
+// worker 수신자 대기열 처리 (전송 한도 초과 시 버림)
+func (n *Notifier) worker(r *receiver) {
+	for event := range r.queue {
+		if !r.limiter.allow(time.Now()) {
+			log.Printf("[Notifier] Rate limit exceeded for %s, dropping event #%d", r.config.Name, event.ID)
+			r.record(func(s *ReceiverStatus) { s.RateLimited++ })
+			continue
+		}
+
+		if err := n.deliver(context.Background(), r, event); err != nil {
+			log.Printf("[Notifier] Failed to notify %s of event #%d: %v", r.config.Name, event.ID, err)
+		}
+	}
+}
+
+// deliver 재시도를 포함해 이벤트 하나 전송하고 현황 갱신
+func (n *Notifier) deliver(ctx context.Context, r *receiver, event eventlog.Event) error {
+	body, err := r.payload(event)
+	if err != nil {
+		err = fmt.Errorf("failed to render payload: %w", err)
+		r.record(func(s *ReceiverStatus) { s.Failed++; s.LastError = err.Error() })
+		return err
+	}
+
+	for attempt := 0; ; attempt++ {
+		err = n.post(ctx, r, body)
+		if err == nil || attempt >= n.maxRetries || !isRetryable(err) {
+			break
+		}
+
+		delay := n.retryDelay << attempt
+		if delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+		log.Printf("[Notifier] Retrying %s in %s (attempt %d/%d): %v", r.config.Name, delay, attempt+1, n.maxRetries, err)
+
+		if waitErr := sleep(ctx, delay); waitErr != nil {
+			err = waitErr
+			break
+		}
+	}
+
+	if err != nil {
+		r.record(func(s *ReceiverStatus) { s.Failed++; s.LastError = err.Error() })
+		return err
+	}
+
+	now := time.Now()
+	r.record(func(s *ReceiverStatus) { s.Sent++; s.LastSentAt = &now; s.LastError = "" })
+	return nil
+}
+
+// post 요청 1회 전송 (secret이 있으면 서명 헤더 추가)
+func (n *Notifier) post(ctx context.Context, r *receiver, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return &deliveryError{message: err.Error()}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "pf-dashboard-notifier")
+	for k, v := range r.config.Headers {
+		req.Header.Set(k, v)
+	}
+	if r.config.Secret != "" {
+		timestamp, signature := signatureHeaders(r.config.Secret, body, time.Now())
+		req.Header.Set(HeaderTimestamp, timestamp)
+		req.Header.Set(HeaderSignature, signature)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		// URL에 토큰이 포함될 수 있으므로 주소는 남기지 않음
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
+		return &deliveryError{message: err.Error(), retryable: true}
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	return &deliveryError{
+		message:   fmt.Sprintf("receiver responded %d", resp.StatusCode),
+		retryable: resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500,
+	}
+}
+
+// Test 테스트 알림을 즉시 전송 (규칙은 무시하고 수신자별 전송 한도는 적용)
+// name이 비어 있으면 모든 수신자에게 전송
+func (n *Notifier) Test(ctx context.Context, name, message string) ([]TestResult, error) {
+	targets := n.receivers
+	if name != "" {
+		r, ok := n.byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown receiver %q", name)
+		}
+		targets = []*receiver{r}
+	}
+	if message == "" {
+		message = "PF Dashboard test notification"
+	}
+
+	now := time.Now()
+	event := eventlog.Event{
+		Type:      "info",
+		Severity:  eventlog.SeverityInfo,
+		Message:   message,
+		Source:    "notifier",
+		Time:      now,
+		Timestamp: now.Format("15:04:05"),
+		Count:     1,
+		FirstSeen: now,
+		LastSeen:  now,
+	}
+
+	results := make([]TestResult, 0, len(targets))
+	for _, r := range targets {
+		result := TestResult{Receiver: r.config.Name, Success: true}
+		if !r.limiter.allow(time.Now()) {
+			r.record(func(s *ReceiverStatus) { s.RateLimited++ })
+			result.Success = false
+			result.Error = "rate limit exceeded"
+		} else if err := n.deliver(ctx, r, event); err != nil {
+			result.Success = false
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Status 설정과 수신자별 현황 조회
+func (n *Notifier) Status() Status {
+	status := Status{
+		Enabled:   n.Enabled(),
+		Source:    n.source,
+		Routes:    n.routes,
+		Receivers: make([]ReceiverStatus, 0, len(n.receivers)),
+	}
+	if status.Routes == nil {
+		status.Routes = []Route{}
+	}
+
+	for _, r := range n.receivers {
+		r.mu.Lock()
+		status.Receivers = append(status.Receivers, r.status)
+		r.mu.Unlock()
+	}
+	return status
+}
+
+// stateKey 이벤트 상태를 추적하는 리소스 식별 키 (리소스가 없으면 소스와 클러스터 단위)
+func stateKey(event eventlog.Event) string {
+	key := []string{event.Source, event.ClusterID}
+	if obj := event.InvolvedObject; obj != nil {
+		key = append(key, obj.Kind, obj.Namespace, obj.Name)
+	}
+	return strings.Join(key, "/")
+}
+
+// record 수신자 현황 갱신
+func (r *receiver) record(update func(s *ReceiverStatus)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	update(&r.status)
+}
+
+// deliveryError 전송 실패 (재시도 가능 여부 포함)
+type deliveryError struct {
+	message   string
+	retryable bool
+}
+
+func (e *deliveryError) Error() string {
+	return e.message
+}
+
+// isRetryable 네트워크 오류, 429, 5xx만 재시도
+func isRetryable(err error) bool {
+	de, ok := err.(*deliveryError)
+	return ok && de.retryable
+}
+
+// sleep ctx가 끝나지 않으면 d만큼 대기
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// endpointOf 상태 표시용 주소 (Slack 등 경로의 토큰 제외)
+func endpointOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "(invalid url)"
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// rateLimiter 분당 전송 수 토큰 버킷
+type rateLimiter struct {
+	perMinute int
+	mu        sync.Mutex
+	tokens    float64
+	last      time.Time
+}
+
+// newRateLimiter 분당 perMinute개 전송 허용 (0 이하면 제한 없음)
+func newRateLimiter(perMinute int) *rateLimiter {
+	return &rateLimiter{perMinute: perMinute, tokens: float64(perMinute)}
+}
+
+// allow 지금 전송할 수 있으면 토큰을 사용하고 true 반환
+func (l *rateLimiter) allow(now time.Time) bool {
+	if l.perMinute <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Minutes() * float64(l.perMinute)
+		if l.tokens > float64(l.perMinute) {
+			l.tokens = float64(l.perMinute)
+		}
+	}
+	l.last = now
+
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
@@ -0,0 +1,118 @@
+package notify
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+)
+
+// 서명 헤더 (X-PF-Signature = "sha256=" + hex(HMAC-SHA256(secret, timestamp + "." + body)))
+const (
+	HeaderTimestamp = "X-PF-Timestamp"
+	HeaderSignature = "X-PF-Signature"
+)
+
+// WebhookPayload 범용 웹훅 본문
+type WebhookPayload struct {
+	Receiver string         `json:"receiver"`
+	Text     string         `json:"text"` // 템플릿으로 만든 메시지
+	Event    eventlog.Event `json:"event"`
+}
+
+// chatPayload Slack/Mattermost incoming webhook 본문
+type chatPayload struct {
+	Text        string           `json:"text"`
+	Channel     string           `json:"channel,omitempty"`
+	Username    string           `json:"username,omitempty"`
+	Attachments []chatAttachment `json:"attachments,omitempty"`
+}
+
+type chatAttachment struct {
+	Color    string      `json:"color"`
+	Fallback string      `json:"fallback"`
+	Fields   []chatField `json:"fields,omitempty"`
+	Ts       int64       `json:"ts"`
+}
+
+type chatField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+// render 수신자 템플릿으로 메시지 생성
+func (r *receiver) render(event eventlog.Event) (string, error) {
+	var buf bytes.Buffer
+	if err := r.template.Execute(&buf, event); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// payload 수신자 종류에 맞는 요청 본문 생성
+func (r *receiver) payload(event eventlog.Event) ([]byte, error) {
+	text, err := r.render(event)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.config.Type == TypeWebhook {
+		return json.Marshal(WebhookPayload{Receiver: r.config.Name, Text: text, Event: event})
+	}
+
+	fields := []chatField{{Title: "Severity", Value: event.Severity, Short: true}}
+	if event.ClusterID != "" {
+		fields = append(fields, chatField{Title: "Cluster", Value: event.ClusterID, Short: true})
+	}
+	if event.Source != "" {
+		fields = append(fields, chatField{Title: "Source", Value: event.Source, Short: true})
+	}
+	if obj := event.InvolvedObject; obj != nil {
+		fields = append(fields, chatField{Title: "Object", Value: obj.Kind + "/" + obj.Name, Short: true})
+	}
+
+	return json.Marshal(chatPayload{
+		Text:     text,
+		Channel:  r.config.Channel,
+		Username: r.config.Username,
+		Attachments: []chatAttachment{{
+			Color:    severityColor(event.Severity),
+			Fallback: text,
+			Fields:   fields,
+			Ts:       event.Time.Unix(),
+		}},
+	})
+}
+
+// Sign 요청 본문 서명 (수신 측 검증에도 사용)
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// signatureHeaders 서명 헤더 값 생성
+func signatureHeaders(secret string, body []byte, now time.Time) (string, string) {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	return timestamp, Sign(secret, timestamp, body)
+}
+
+// severityColor 심각도별 첨부 색상
+func severityColor(severity string) string {
+	switch severity {
+	case eventlog.SeverityCritical:
+		return "#dc2626"
+	case eventlog.SeverityWarning:
+		return "#f59e0b"
+	default:
+		return "#2563eb"
+	}
+}
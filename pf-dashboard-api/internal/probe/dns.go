@@ -0,0 +1,427 @@
+package probe
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	"github.com/minkyulee/pf-dashboard-backend/internal/gslb"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// DNSAnswer DNS 응답 레코드
+type DNSAnswer struct {
+	Type  string `json:"type"` // A, AAAA, CNAME
+	Value string `json:"value"`
+	TTL   uint32 `json:"ttl"`
+}
+
+// DNSResult GSLB 도메인 질의 결과
+type DNSResult struct {
+	Domain     string      `json:"domain"`
+	GSLBName   string      `json:"gslbName"`
+	Server     string      `json:"server"`
+	Time       time.Time   `json:"time"`
+	LatencyMs  int64       `json:"latencyMs"`
+	Rcode      string      `json:"rcode,omitempty"`
+	Error      string      `json:"error,omitempty"`
+	Answers    []DNSAnswer `json:"answers"`
+	Addresses  []string    `json:"addresses"`  // 응답의 A/AAAA/CNAME 값
+	Expected   []string    `json:"expected"`   // 활성 상태여야 하는 엔드포인트
+	Unexpected []string    `json:"unexpected"` // 비활성 엔드포인트 또는 GSLB에 없는 주소
+	Missing    []string    `json:"missing"`    // 활성이지만 응답에 없는 엔드포인트 (라우팅 규칙상 정상일 수 있음)
+	Match      bool        `json:"match"`      // 응답이 있고 예상 밖 주소가 없는지
+}
+
+// DNSProbe GSLB 도메인 DNS 해석 결과를 주기적으로 기록하는 프로브
+type DNSProbe struct {
+	provider   gslb.Provider
+	eventLog   *eventlog.EventLog
+	servers    []string
+	interval   time.Duration
+	timeout    time.Duration
+	maxHistory int
+	history    map[string][]DNSResult // [domain]
+	lastMatch  map[string]bool        // [domain/server]
+	mu         sync.RWMutex
+}
+
+// NewDNSProbeFromEnv 환경변수 기반 DNS 프로브 생성
+// DNS_PROBE_SERVERS가 없으면 /etc/resolv.conf의 nameserver를 사용
+func NewDNSProbeFromEnv(provider gslb.Provider, eventLog *eventlog.EventLog) *DNSProbe {
+	servers := splitList(os.Getenv("DNS_PROBE_SERVERS"))
+	if len(servers) == 0 {
+		servers = systemNameservers("/etc/resolv.conf")
+	}
+	for i, server := range servers {
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			servers[i] = net.JoinHostPort(server, "53")
+		}
+	}
+
+	return NewDNSProbe(provider, eventLog, servers,
+		getDurationEnv("DNS_PROBE_INTERVAL", 60*time.Second),
+		getIntEnv("DNS_PROBE_HISTORY", 100))
+}
+
+// NewDNSProbe 새 DNS 프로브 생성 (servers는 host:port 형식)
+func NewDNSProbe(provider gslb.Provider, eventLog *eventlog.EventLog, servers []string, interval time.Duration, maxHistory int) *DNSProbe {
+	return &DNSProbe{
+		provider:   provider,
+		eventLog:   eventLog,
+		servers:    servers,
+		interval:   interval,
+		timeout:    5 * time.Second,
+		maxHistory: maxHistory,
+		history:    make(map[string][]DNSResult),
+		lastMatch:  make(map[string]bool),
+	}
+}
+
+// Servers 질의 대상 DNS 서버 목록
+func (p *DNSProbe) Servers() []string {
+	return append([]string(nil), p.servers...)
+}
+
+// Run 주기적으로 모든 GSLB 도메인 질의 (interval이 0이면 비활성)
+func (p *DNSProbe) Run() {
+	if p.interval <= 0 || len(p.servers) == 0 {
+		log.Printf("[DNSProbe] Disabled (interval: %s, servers: %d)", p.interval, len(p.servers))
+		return
+	}
+
+	log.Printf("[DNSProbe] Started (interval: %s, servers: %s)", p.interval, strings.Join(p.servers, ", "))
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	p.ProbeOnce(context.Background())
+	for range ticker.C {
+		p.ProbeOnce(context.Background())
+	}
+}
+
+// ProbeOnce 모든 GSLB 도메인을 모든 DNS 서버로 한 번 질의
+func (p *DNSProbe) ProbeOnce(ctx context.Context) []DNSResult {
+	gslbs, err := p.provider.ListGSLBs(ctx)
+	if err != nil {
+		log.Printf("[DNSProbe] Failed to list GSLBs: %v", err)
+		return nil
+	}
+
+	results := make([]DNSResult, 0)
+	for _, g := range gslbs {
+		if g.Disabled || g.Domain == "" {
+			continue
+		}
+
+		expected, disabled := endpointSets(g)
+		for _, server := range p.servers {
+			result := p.probe(ctx, g, server, expected, disabled)
+			p.record(result)
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// History 도메인별 질의 이력 조회 (domain이 비어 있으면 전체, 최신순)
+func (p *DNSProbe) History(domain string, limit int) []DNSResult {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	results := make([]DNSResult, 0)
+	for d, entries := range p.history {
+		if domain != "" && d != domain {
+			continue
+		}
+		results = append(results, entries...)
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Time.After(results[j].Time) })
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// probe 단일 도메인/서버 질의 및 예상 엔드포인트와 비교
+func (p *DNSProbe) probe(ctx context.Context, g gslb.GSLB, server string, expected, disabled map[string]bool) DNSResult {
+	result := DNSResult{
+		Domain:     g.Domain,
+		GSLBName:   g.Name,
+		Server:     server,
+		Time:       time.Now(),
+		Answers:    []DNSAnswer{},
+		Addresses:  []string{},
+		Expected:   sortedKeys(expected),
+		Unexpected: []string{},
+		Missing:    []string{},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	for _, qtype := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+		answers, rcode, err := Query(ctx, server, g.Domain, qtype)
+		if err != nil {
+			result.Error = err.Error()
+			break
+		}
+		if rcode != dnsmessage.RCodeSuccess {
+			result.Rcode = rcode.String()
+		}
+		result.Answers = append(result.Answers, answers...)
+	}
+	result.LatencyMs = time.Since(result.Time).Milliseconds()
+
+	// CNAME 체인 중 예상 엔드포인트(호스트명)가 있으면 그 뒤의 A/AAAA는 해당 엔드포인트로 간주
+	coveredByCNAME := false
+	seen := make(map[string]bool)
+	for _, answer := range result.Answers {
+		value := answer.Value
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		result.Addresses = append(result.Addresses, value)
+
+		if answer.Type == "CNAME" {
+			if expected[value] {
+				coveredByCNAME = true
+			} else if disabled[value] {
+				result.Unexpected = append(result.Unexpected, value)
+			}
+			continue
+		}
+
+		if disabled[value] || (!expected[value] && !coveredByCNAME) {
+			result.Unexpected = append(result.Unexpected, value)
+		}
+	}
+
+	for _, address := range result.Expected {
+		if !seen[address] {
+			result.Missing = append(result.Missing, address)
+		}
+	}
+
+	result.Match = result.Error == "" && len(result.Addresses) > 0 && len(result.Unexpected) == 0
+	return result
+}
+
+// record 결과를 이력에 추가하고 일치 여부가 바뀌면 이벤트 기록
+func (p *DNSProbe) record(result DNSResult) {
+	p.mu.Lock()
+	entries := append(p.history[result.Domain], result)
+	if p.maxHistory > 0 && len(entries) > p.maxHistory {
+		entries = entries[len(entries)-p.maxHistory:]
+	}
+	p.history[result.Domain] = entries
+
+	key := result.Domain + "/" + result.Server
+	lastMatch, exists := p.lastMatch[key]
+	p.lastMatch[key] = result.Match
+	p.mu.Unlock()
+
+	if !exists && result.Match {
+		return
+	}
+	if exists && lastMatch == result.Match {
+		return
+	}
+
+	if result.Match {
+		p.eventLog.Record(eventlog.Event{
+			Type:           "success",
+			Message:        fmt.Sprintf("DNS %s (via %s) now resolves to expected endpoints: %s", result.Domain, result.Server, strings.Join(result.Addresses, ", ")),
+			Source:         "dns-probe",
+			InvolvedObject: &eventlog.ObjectReference{Kind: "GSLB", Name: result.GSLBName},
+			Attributes:     map[string]string{"domain": result.Domain, "server": result.Server},
+		})
+		return
+	}
+
+	reason := result.Error
+	switch {
+	case reason != "":
+	case len(result.Addresses) == 0:
+		reason = "no addresses returned"
+		if result.Rcode != "" {
+			reason += " (" + result.Rcode + ")"
+		}
+	default:
+		reason = "unexpected addresses " + strings.Join(result.Unexpected, ", ")
+	}
+	log.Printf("[DNSProbe] %s via %s mismatch: %s", result.Domain, result.Server, reason)
+	p.eventLog.Record(eventlog.Event{
+		Type:           "critical",
+		Message:        fmt.Sprintf("DNS %s (via %s) mismatch: %s", result.Domain, result.Server, reason),
+		Source:         "dns-probe",
+		InvolvedObject: &eventlog.ObjectReference{Kind: "GSLB", Name: result.GSLBName},
+		Attributes:     map[string]string{"domain": result.Domain, "server": result.Server, "reason": reason},
+	})
+}
+
+// Query DNS 서버에 단일 질의를 UDP로 보내고 응답 레코드 반환 (잘린 응답은 TCP로 재질의)
+func Query(ctx context.Context, server, domain string, qtype dnsmessage.Type) ([]DNSAnswer, dnsmessage.RCode, error) {
+	name, err := dnsmessage.NewName(fqdn(domain))
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid domain %q: %w", domain, err)
+	}
+
+	id := uint16(rand.Intn(1 << 16))
+	query := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: id, RecursionDesired: true},
+		Questions: []dnsmessage.Question{{Name: name, Type: qtype, Class: dnsmessage.ClassINET}},
+	}
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to pack query: %w", err)
+	}
+
+	resp, err := exchange(ctx, "udp", server, packed)
+	if err == nil && resp.Header.Truncated {
+		resp, err = exchange(ctx, "tcp", server, packed)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	if resp.Header.ID != id {
+		return nil, 0, fmt.Errorf("response ID mismatch")
+	}
+
+	answers := make([]DNSAnswer, 0, len(resp.Answers))
+	for _, rr := range resp.Answers {
+		answer := DNSAnswer{TTL: rr.Header.TTL}
+		switch body := rr.Body.(type) {
+		case *dnsmessage.AResource:
+			answer.Type = "A"
+			answer.Value = net.IP(body.A[:]).String()
+		case *dnsmessage.AAAAResource:
+			answer.Type = "AAAA"
+			answer.Value = net.IP(body.AAAA[:]).String()
+		case *dnsmessage.CNAMEResource:
+			answer.Type = "CNAME"
+			answer.Value = strings.TrimSuffix(body.CNAME.String(), ".")
+		default:
+			continue
+		}
+		answers = append(answers, answer)
+	}
+
+	return answers, resp.Header.RCode, nil
+}
+
+// exchange 질의 전송 후 응답 수신
+func exchange(ctx context.Context, network, server string, packed []byte) (*dnsmessage.Message, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, server)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", server, err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if network == "tcp" {
+		// TCP는 2바이트 길이 접두사 사용
+		packed = append([]byte{byte(len(packed) >> 8), byte(len(packed))}, packed...)
+	}
+	if _, err := conn.Write(packed); err != nil {
+		return nil, fmt.Errorf("failed to send query: %w", err)
+	}
+
+	var data []byte
+	if network == "tcp" {
+		// 길이 접두사만큼 정확히 읽음
+		var prefix [2]byte
+		if _, err := io.ReadFull(conn, prefix[:]); err != nil {
+			return nil, fmt.Errorf("failed to read response length: %w", err)
+		}
+		data = make([]byte, int(prefix[0])<<8|int(prefix[1]))
+		if _, err := io.ReadFull(conn, data); err != nil {
+			return nil, fmt.Errorf("failed to read response: %w", err)
+		}
+	} else {
+		// UDP 응답은 데이터그램 하나
+		buf := make([]byte, 65535)
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response: %w", err)
+		}
+		data = buf[:n]
+	}
+
+	var msg dnsmessage.Message
+	if err := msg.Unpack(data); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &msg, nil
+}
+
+// endpointSets GSLB의 활성/비활성 엔드포인트 주소 집합
+func endpointSets(g gslb.GSLB) (map[string]bool, map[string]bool) {
+	expected := make(map[string]bool)
+	disabled := make(map[string]bool)
+	for _, cp := range g.Pools {
+		for _, ep := range cp.Pool.Endpoints {
+			if cp.Pool.Disabled || ep.Disabled {
+				disabled[ep.Address] = true
+			} else {
+				expected[ep.Address] = true
+			}
+		}
+	}
+	// 같은 주소가 다른 풀에서 활성이면 비활성으로 보지 않음
+	for address := range expected {
+		delete(disabled, address)
+	}
+	return expected, disabled
+}
+
+// systemNameservers resolv.conf의 nameserver 목록
+func systemNameservers(path string) []string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	servers := make([]string, 0)
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			servers = append(servers, fields[1])
+		}
+	}
+	return servers
+}
+
+// fqdn 도메인을 FQDN(끝에 점)으로 변환
+func fqdn(domain string) string {
+	if strings.HasSuffix(domain, ".") {
+		return domain
+	}
+	return domain + "."
+}
+
+// sortedKeys 집합을 정렬된 목록으로 변환
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,291 @@
+package probe
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	"github.com/minkyulee/pf-dashboard-backend/internal/gslb"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// dnsHandler 질의에 대한 응답 생성 (tcp는 TCP로 받은 질의인지)
+type dnsHandler func(query dnsmessage.Message, tcp bool) dnsmessage.Message
+
+// startDNSServer 같은 포트에서 UDP/TCP로 응답하는 테스트용 DNS 서버 시작 (host:port 반환)
+func startDNSServer(t *testing.T, handler dnsHandler) string {
+	t.Helper()
+
+	var udp net.PacketConn
+	var tcp net.Listener
+	for attempt := 0; attempt < 10 && tcp == nil; attempt++ {
+		var err error
+		udp, err = net.ListenPacket("udp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("listen udp: %v", err)
+		}
+		tcp, err = net.Listen("tcp", udp.LocalAddr().String())
+		if err != nil {
+			udp.Close()
+		}
+	}
+	if tcp == nil {
+		t.Fatal("failed to listen on the same UDP/TCP port")
+	}
+	t.Cleanup(func() {
+		udp.Close()
+		tcp.Close()
+	})
+
+	go func() {
+		buf := make([]byte, 65535)
+		for {
+			n, addr, err := udp.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			var query dnsmessage.Message
+			if err := query.Unpack(buf[:n]); err != nil {
+				continue
+			}
+			resp := handler(query, false)
+			packed, err := resp.Pack()
+			if err != nil {
+				t.Errorf("pack udp response: %v", err)
+				return
+			}
+			udp.WriteTo(packed, addr)
+		}
+	}()
+
+	go func() {
+		for {
+			conn, err := tcp.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				var prefix [2]byte
+				if _, err := io.ReadFull(conn, prefix[:]); err != nil {
+					return
+				}
+				data := make([]byte, binary.BigEndian.Uint16(prefix[:]))
+				if _, err := io.ReadFull(conn, data); err != nil {
+					return
+				}
+				var query dnsmessage.Message
+				if err := query.Unpack(data); err != nil {
+					return
+				}
+				resp := handler(query, true)
+				packed, err := resp.Pack()
+				if err != nil {
+					t.Errorf("pack tcp response: %v", err)
+					return
+				}
+				// 길이 접두사와 본문을 나눠 보내 여러 번 읽어야 하도록 함
+				binary.BigEndian.PutUint16(prefix[:], uint16(len(packed)))
+				conn.Write(prefix[:])
+				for len(packed) > 0 {
+					n := 4096
+					if n > len(packed) {
+						n = len(packed)
+					}
+					conn.Write(packed[:n])
+					packed = packed[n:]
+				}
+			}(conn)
+		}
+	}()
+
+	return udp.LocalAddr().String()
+}
+
+// reply 질의에 대한 기본 응답 헤더
+func reply(query dnsmessage.Message) dnsmessage.Message {
+	return dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: query.Header.ID, Response: true, RecursionDesired: true},
+		Questions: query.Questions,
+	}
+}
+
+// aRecord A 레코드
+func aRecord(name dnsmessage.Name, ip string) dnsmessage.Resource {
+	var a [4]byte
+	copy(a[:], net.ParseIP(ip).To4())
+	return dnsmessage.Resource{
+		Header: dnsmessage.ResourceHeader{Name: name, Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: 30},
+		Body:   &dnsmessage.AResource{A: a},
+	}
+}
+
+// padTo TXT 레코드를 덧붙여 패킹된 응답 크기를 size 바이트로 맞춤
+func padTo(t *testing.T, msg dnsmessage.Message, size int) dnsmessage.Message {
+	t.Helper()
+
+	name := msg.Questions[0].Name
+	txt := dnsmessage.Resource{
+		Header: dnsmessage.ResourceHeader{Name: name, Type: dnsmessage.TypeTXT, Class: dnsmessage.ClassINET},
+		Body:   &dnsmessage.TXTResource{TXT: []string{""}},
+	}
+	msg.Additionals = append(msg.Additionals, txt)
+
+	for i := 0; i < 1000; i++ {
+		packed, err := msg.Pack()
+		if err != nil {
+			t.Fatalf("pack padded response: %v", err)
+		}
+		diff := size - len(packed)
+		if diff == 0 {
+			return msg
+		}
+
+		body := msg.Additionals[len(msg.Additionals)-1].Body.(*dnsmessage.TXTResource)
+		last := body.TXT[len(body.TXT)-1]
+		switch {
+		case diff > 0 && len(last) == 255:
+			body.TXT = append(body.TXT, "")
+		case diff > 0:
+			grow := diff
+			if len(last)+grow > 255 {
+				grow = 255 - len(last)
+			}
+			body.TXT[len(body.TXT)-1] = last + strings.Repeat("x", grow)
+		default:
+			if len(last) < -diff {
+				t.Fatalf("cannot shrink padding by %d bytes", -diff)
+			}
+			body.TXT[len(body.TXT)-1] = last[:len(last)+diff]
+		}
+	}
+	t.Fatalf("failed to pad response to %d bytes", size)
+	return msg
+}
+
+func TestQueryUDP(t *testing.T) {
+	server := startDNSServer(t, func(query dnsmessage.Message, tcp bool) dnsmessage.Message {
+		resp := reply(query)
+		if query.Questions[0].Type == dnsmessage.TypeA {
+			resp.Answers = []dnsmessage.Resource{aRecord(query.Questions[0].Name, "10.0.0.1")}
+		}
+		return resp
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	answers, rcode, err := Query(ctx, server, "app.example.com", dnsmessage.TypeA)
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if rcode != dnsmessage.RCodeSuccess {
+		t.Errorf("rcode = %v, want success", rcode)
+	}
+	if len(answers) != 1 || answers[0].Type != "A" || answers[0].Value != "10.0.0.1" || answers[0].TTL != 30 {
+		t.Errorf("answers = %+v, want one A 10.0.0.1 TTL 30", answers)
+	}
+}
+
+func TestQueryTruncatedFallsBackToTCP(t *testing.T) {
+	// 최대 크기(65535바이트) TCP 응답도 길이 접두사만큼 읽고 끝나야 함
+	for _, size := range []int{1024, 65535} {
+		server := startDNSServer(t, func(query dnsmessage.Message, tcp bool) dnsmessage.Message {
+			resp := reply(query)
+			if !tcp {
+				resp.Header.Truncated = true
+				return resp
+			}
+			for i := 1; i <= 20; i++ {
+				resp.Answers = append(resp.Answers, aRecord(query.Questions[0].Name, net.IPv4(10, 0, 1, byte(i)).String()))
+			}
+			return padTo(t, resp, size)
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		answers, _, err := Query(ctx, server, "app.example.com", dnsmessage.TypeA)
+		cancel()
+		if err != nil {
+			t.Fatalf("size %d: Query: %v", size, err)
+		}
+		if len(answers) != 20 {
+			t.Errorf("size %d: got %d answers, want 20", size, len(answers))
+		}
+	}
+}
+
+// fakeGSLBProvider 고정된 GSLB 목록을 반환하는 공급자
+type fakeGSLBProvider struct {
+	gslbs []gslb.GSLB
+}
+
+func (f *fakeGSLBProvider) Name() string { return "fake" }
+
+func (f *fakeGSLBProvider) ListGSLBs(ctx context.Context) ([]gslb.GSLB, error) { return f.gslbs, nil }
+
+func (f *fakeGSLBProvider) ListPools(ctx context.Context) ([]gslb.Pool, error) { return nil, nil }
+
+func (f *fakeGSLBProvider) ListEndpoints(ctx context.Context, poolID string) ([]gslb.Endpoint, error) {
+	return nil, nil
+}
+
+func (f *fakeGSLBProvider) GetHealth(ctx context.Context) ([]gslb.EndpointHealth, error) {
+	return nil, nil
+}
+
+func (f *fakeGSLBProvider) UpdateEndpoint(ctx context.Context, poolID string, endpoint gslb.Endpoint) error {
+	return nil
+}
+
+func TestDNSProbeReportsDisabledEndpoint(t *testing.T) {
+	answer := "10.0.0.1"
+	server := startDNSServer(t, func(query dnsmessage.Message, tcp bool) dnsmessage.Message {
+		resp := reply(query)
+		if query.Questions[0].Type == dnsmessage.TypeA {
+			resp.Answers = []dnsmessage.Resource{aRecord(query.Questions[0].Name, answer)}
+		}
+		return resp
+	})
+
+	provider := &fakeGSLBProvider{gslbs: []gslb.GSLB{{
+		Name:   "app",
+		Domain: "app.example.com",
+		Pools: []gslb.ConnectedPool{
+			{Pool: gslb.Pool{ID: "p1", Name: "member1", Endpoints: []gslb.Endpoint{{Address: "10.0.0.1"}}}},
+			{Pool: gslb.Pool{ID: "p2", Name: "member2", Endpoints: []gslb.Endpoint{{Address: "10.0.0.2", Disabled: true}}}},
+		},
+	}}}
+	eventLog := eventlog.NewEventLog(10)
+	p := NewDNSProbe(provider, eventLog, []string{server}, time.Minute, 10)
+
+	results := p.ProbeOnce(context.Background())
+	if len(results) != 1 || !results[0].Match {
+		t.Fatalf("results = %+v, want one matching result", results)
+	}
+	if len(eventLog.GetEvents()) != 0 {
+		t.Errorf("first matching result recorded events: %+v", eventLog.GetEvents())
+	}
+
+	// 비활성 엔드포인트가 응답되면 불일치 이벤트
+	answer = "10.0.0.2"
+	results = p.ProbeOnce(context.Background())
+	if len(results) != 1 || results[0].Match {
+		t.Fatalf("results = %+v, want one mismatching result", results)
+	}
+	if got := results[0].Unexpected; len(got) != 1 || got[0] != "10.0.0.2" {
+		t.Errorf("unexpected = %v, want [10.0.0.2]", got)
+	}
+
+	events := eventLog.GetEvents()
+	if len(events) != 1 || events[0].Type != "critical" || events[0].Source != "dns-probe" {
+		t.Fatalf("events = %+v, want one critical dns-probe event", events)
+	}
+	if history := p.History("app.example.com", 0); len(history) != 2 {
+		t.Errorf("history has %d results, want 2", len(history))
+	}
+}
@@ -0,0 +1,489 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	"github.com/minkyulee/pf-dashboard-backend/internal/gslb"
+	"github.com/minkyulee/pf-dashboard-backend/internal/monitor"
+)
+
+// 프로브 대상 종류
+const (
+	TargetGSLBEndpoint = "gslb-endpoint"
+	TargetIngress      = "ingress"
+)
+
+// IsolationSource 자동 Failover로 격리된 엔드포인트 조회 (failover.Controller가 구현)
+// 격리된 엔드포인트도 계속 프로브해야 장애 지속/실제 복구를 판단할 수 있음
+type IsolationSource interface {
+	IsIsolated(poolID, address string) bool
+}
+
+// HTTPCheck HTTP 프로브 요청/판정 조건
+type HTTPCheck struct {
+	Scheme         string         `json:"scheme"`
+	Port           string         `json:"port,omitempty"`
+	Path           string         `json:"path"`
+	ExpectedStatus int            `json:"expectedStatus"`
+	BodyMatch      *regexp.Regexp `json:"-"`
+	Timeout        time.Duration  `json:"-"`
+}
+
+// HTTPTarget HTTP 프로브 대상
+type HTTPTarget struct {
+	ID        string `json:"id"`
+	Kind      string `json:"kind"` // gslb-endpoint, ingress
+	ClusterID string `json:"clusterId,omitempty"`
+	URL       string `json:"url"`
+	Host      string `json:"host,omitempty"`     // Host 헤더 및 TLS SNI
+	Isolated  bool   `json:"isolated,omitempty"` // 자동 Failover로 비활성화된 엔드포인트
+}
+
+// HTTPResult HTTP 프로브 결과
+type HTTPResult struct {
+	HTTPTarget
+	Time                time.Time  `json:"time"`
+	LatencyMs           int64      `json:"latencyMs"`
+	StatusCode          int        `json:"statusCode,omitempty"`
+	Success             bool       `json:"success"`
+	Error               string     `json:"error,omitempty"`
+	TLSExpiry           *time.Time `json:"tlsExpiry,omitempty"`
+	TLSDaysLeft         *int       `json:"tlsDaysLeft,omitempty"`
+	ConsecutiveFailures int        `json:"consecutiveFailures"`
+}
+
+// HTTPProbe GSLB 엔드포인트와 클러스터 Ingress에 대한 합성 HTTP 프로브 스케줄러
+type HTTPProbe struct {
+	provider         gslb.Provider
+	eventLog         *eventlog.EventLog
+	check            HTTPCheck
+	ingresses        []HTTPTarget
+	clusterIDs       []string
+	interval         time.Duration
+	failureThreshold int
+	maxHistory       int
+	client           *http.Client
+	insecure         bool
+	isolation        IsolationSource
+
+	gslbLast []HTTPTarget            // 마지막으로 조회에 성공한 GSLB 대상
+	latest   map[string]HTTPResult   // [targetID]
+	history  map[string][]HTTPResult // [targetID]
+	alerting map[string]bool         // 연속 실패 이벤트를 보낸 대상
+	mu       sync.RWMutex
+}
+
+// NewHTTPProbeFromEnv 환경변수 기반 HTTP 프로브 생성
+func NewHTTPProbeFromEnv(provider gslb.Provider, eventLog *eventlog.EventLog) *HTTPProbe {
+	check := HTTPCheck{
+		Scheme:         getEnvDefault("HTTP_PROBE_SCHEME", "https"),
+		Port:           os.Getenv("HTTP_PROBE_PORT"),
+		Path:           getEnvDefault("HTTP_PROBE_PATH", "/"),
+		ExpectedStatus: getIntEnv("HTTP_PROBE_EXPECTED_STATUS", http.StatusOK),
+		Timeout:        getDurationEnv("HTTP_PROBE_TIMEOUT", 5*time.Second),
+	}
+	if pattern := os.Getenv("HTTP_PROBE_BODY_MATCH"); pattern != "" {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			log.Printf("Warning: invalid HTTP_PROBE_BODY_MATCH %q: %v", pattern, err)
+		} else {
+			check.BodyMatch = re
+		}
+	}
+
+	clusterIDs := splitList(os.Getenv("HTTP_PROBE_CLUSTERS"))
+	if len(clusterIDs) == 0 {
+		clusterIDs = []string{"member1", "member2"}
+	}
+
+	return NewHTTPProbe(provider, eventLog, check,
+		parseIngressTargets(os.Getenv("HTTP_PROBE_INGRESSES")),
+		clusterIDs,
+		getDurationEnv("HTTP_PROBE_INTERVAL", 30*time.Second),
+		getIntEnv("HTTP_PROBE_FAILURE_THRESHOLD", 3),
+		os.Getenv("HTTP_PROBE_INSECURE") == "true")
+}
+
+// NewHTTPProbe 새 HTTP 프로브 생성
+func NewHTTPProbe(provider gslb.Provider, eventLog *eventlog.EventLog, check HTTPCheck, ingresses []HTTPTarget,
+	clusterIDs []string, interval time.Duration, failureThreshold int, insecure bool) *HTTPProbe {
+	if failureThreshold < 1 {
+		failureThreshold = 1
+	}
+
+	return &HTTPProbe{
+		provider:         provider,
+		eventLog:         eventLog,
+		check:            check,
+		ingresses:        ingresses,
+		clusterIDs:       clusterIDs,
+		interval:         interval,
+		failureThreshold: failureThreshold,
+		maxHistory:       50,
+		insecure:         insecure,
+		client: &http.Client{
+			Timeout: check.Timeout,
+			// 리다이렉트는 따라가지 않고 응답 코드 그대로 판정
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		latest:   make(map[string]HTTPResult),
+		history:  make(map[string][]HTTPResult),
+		alerting: make(map[string]bool),
+	}
+}
+
+// SetIsolationSource 자동 Failover 격리 상태 소스 설정
+func (p *HTTPProbe) SetIsolationSource(source IsolationSource) {
+	p.isolation = source
+}
+
+// Run 주기적으로 모든 대상 프로브 (interval이 0이면 비활성)
+func (p *HTTPProbe) Run() {
+	if p.interval <= 0 {
+		log.Printf("[HTTPProbe] Disabled (HTTP_PROBE_INTERVAL=0)")
+		return
+	}
+
+	log.Printf("[HTTPProbe] Started (interval: %s, path: %s, expected status: %d, ingresses: %d)",
+		p.interval, p.check.Path, p.check.ExpectedStatus, len(p.ingresses))
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	p.ProbeOnce(context.Background())
+	for range ticker.C {
+		p.ProbeOnce(context.Background())
+	}
+}
+
+// ProbeOnce 모든 대상을 병렬로 한 번 프로브
+func (p *HTTPProbe) ProbeOnce(ctx context.Context) []HTTPResult {
+	targets := append(p.gslbTargets(ctx), p.ingresses...)
+
+	results := make([]HTTPResult, len(targets))
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target HTTPTarget) {
+			defer wg.Done()
+			results[i] = p.probe(ctx, target)
+		}(i, target)
+	}
+	wg.Wait()
+
+	current := make(map[string]bool, len(results))
+	for i := range results {
+		results[i] = p.record(results[i])
+		current[results[i].ID] = true
+	}
+	p.prune(current)
+	return results
+}
+
+// prune 더 이상 대상이 아닌 항목 제거 (운영자가 비활성화/삭제한 엔드포인트 등)
+// 실패 이벤트를 보낸 대상은 인시던트와 알림이 남지 않도록 해제 이벤트 기록
+func (p *HTTPProbe) prune(current map[string]bool) {
+	p.mu.Lock()
+	removed := make([]HTTPResult, 0)
+	for id, result := range p.latest {
+		if current[id] {
+			continue
+		}
+		if p.alerting[id] {
+			removed = append(removed, result)
+		}
+		delete(p.latest, id)
+		delete(p.history, id)
+		delete(p.alerting, id)
+	}
+	p.mu.Unlock()
+
+	for _, result := range removed {
+		log.Printf("[HTTPProbe] %s removed from probe targets while failing", result.URL)
+		event := probeEvent(result)
+		event.Type = "success"
+		event.Message = fmt.Sprintf("HTTP probe %s removed from probe targets", probeLabel(result))
+		event.Attributes["reason"] = "target removed"
+		p.eventLog.Record(event)
+	}
+}
+
+// Latest 대상별 최근 결과 조회
+func (p *HTTPProbe) Latest() []HTTPResult {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	results := make([]HTTPResult, 0, len(p.latest))
+	for _, result := range p.latest {
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].ID < results[j].ID })
+	return results
+}
+
+// History 대상별 결과 이력 조회 (최신순)
+func (p *HTTPProbe) History(targetID string) []HTTPResult {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	entries := p.history[targetID]
+	results := make([]HTTPResult, 0, len(entries))
+	for i := len(entries) - 1; i >= 0; i-- {
+		results = append(results, entries[i])
+	}
+	return results
+}
+
+// ClusterProbeHealth 클러스터별 프로브 결과 요약 (monitor.ProbeHealthSource 구현)
+// 연속 실패 횟수가 임계값 이상인 대상만 실패로 집계
+func (p *HTTPProbe) ClusterProbeHealth(clusterID string) (monitor.ProbeHealth, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	health := monitor.ProbeHealth{}
+	var latencySum, succeeded int64
+	for _, result := range p.latest {
+		if result.ClusterID != clusterID {
+			continue
+		}
+		health.Total++
+		if result.ConsecutiveFailures >= p.failureThreshold {
+			health.Failing++
+			health.FailingTargets = append(health.FailingTargets, result.URL)
+		}
+		if result.Success {
+			latencySum += result.LatencyMs
+			succeeded++
+		}
+	}
+
+	if health.Total == 0 {
+		return health, false
+	}
+	if succeeded > 0 {
+		health.AvgLatencyMs = latencySum / succeeded
+	}
+	return health, true
+}
+
+// gslbTargets 모든 GSLB의 활성 엔드포인트와 자동 Failover로 격리된 엔드포인트를 프로브 대상으로 변환
+// GSLB 조회에 실패하면 대상이 사라져 정상으로 보이지 않도록 마지막 대상을 그대로 사용
+func (p *HTTPProbe) gslbTargets(ctx context.Context) []HTTPTarget {
+	gslbs, err := p.provider.ListGSLBs(ctx)
+	if err != nil {
+		log.Printf("[HTTPProbe] Failed to list GSLBs, reusing last targets: %v", err)
+		p.mu.RLock()
+		defer p.mu.RUnlock()
+		return append([]HTTPTarget(nil), p.gslbLast...)
+	}
+
+	targets := make([]HTTPTarget, 0)
+	for _, g := range gslbs {
+		if g.Disabled {
+			continue
+		}
+		for _, cp := range g.Pools {
+			for _, ep := range cp.Pool.Endpoints {
+				isolated := p.isolation != nil && p.isolation.IsIsolated(cp.Pool.ID, ep.Address)
+				if (cp.Pool.Disabled || ep.Disabled) && !isolated {
+					continue
+				}
+
+				host := ep.Address
+				if p.check.Port != "" {
+					host = net.JoinHostPort(ep.Address, p.check.Port)
+				}
+				targets = append(targets, HTTPTarget{
+					ID:        fmt.Sprintf("%s/%s/%s", g.Name, cp.Pool.ID, ep.Address),
+					Kind:      TargetGSLBEndpoint,
+					ClusterID: p.clusterForPool(cp.Pool.Name),
+					URL:       fmt.Sprintf("%s://%s%s", p.check.Scheme, host, p.check.Path),
+					Host:      g.Domain,
+					Isolated:  isolated,
+				})
+			}
+		}
+	}
+
+	p.mu.Lock()
+	p.gslbLast = targets
+	p.mu.Unlock()
+	return targets
+}
+
+// clusterForPool 풀 이름에 포함된 클러스터 ID 검색
+func (p *HTTPProbe) clusterForPool(poolName string) string {
+	name := strings.ToLower(poolName)
+	for _, clusterID := range p.clusterIDs {
+		if strings.Contains(name, strings.ToLower(clusterID)) {
+			return clusterID
+		}
+	}
+	return ""
+}
+
+// probe 단일 대상 HTTP 요청 및 판정
+func (p *HTTPProbe) probe(ctx context.Context, target HTTPTarget) HTTPResult {
+	result := HTTPResult{HTTPTarget: target, Time: time.Now()}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.URL, nil)
+	if err != nil {
+		result.Error = fmt.Sprintf("invalid request: %v", err)
+		return result
+	}
+	req.Header.Set("User-Agent", "pf-dashboard-probe/1.0")
+	if target.Host != "" {
+		req.Host = target.Host
+	}
+
+	resp, err := p.transportFor(target).Do(req)
+	result.LatencyMs = time.Since(result.Time).Milliseconds()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer resp.Body.Close()
+
+	result.StatusCode = resp.StatusCode
+	if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+		expiry := resp.TLS.PeerCertificates[0].NotAfter
+		daysLeft := int(time.Until(expiry).Hours() / 24)
+		result.TLSExpiry = &expiry
+		result.TLSDaysLeft = &daysLeft
+	}
+
+	if resp.StatusCode != p.check.ExpectedStatus {
+		result.Error = fmt.Sprintf("unexpected status %d (expected %d)", resp.StatusCode, p.check.ExpectedStatus)
+		return result
+	}
+
+	if p.check.BodyMatch != nil {
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		if err != nil {
+			result.Error = fmt.Sprintf("failed to read body: %v", err)
+			return result
+		}
+		if !p.check.BodyMatch.Match(body) {
+			result.Error = fmt.Sprintf("body does not match %q", p.check.BodyMatch.String())
+			return result
+		}
+	}
+
+	result.Success = true
+	return result
+}
+
+// transportFor 대상별 HTTP 클라이언트 (Host 헤더가 있으면 SNI도 같은 이름 사용)
+func (p *HTTPProbe) transportFor(target HTTPTarget) *http.Client {
+	tlsConfig := &tls.Config{InsecureSkipVerify: p.insecure}
+	if target.Host != "" {
+		tlsConfig.ServerName = target.Host
+	}
+
+	client := *p.client
+	client.Transport = &http.Transport{
+		Proxy:             http.ProxyFromEnvironment,
+		TLSClientConfig:   tlsConfig,
+		DisableKeepAlives: true,
+	}
+	return &client
+}
+
+// record 결과 저장 및 연속 실패/복구 이벤트 기록
+func (p *HTTPProbe) record(result HTTPResult) HTTPResult {
+	p.mu.Lock()
+	previous, exists := p.latest[result.ID]
+	if !result.Success {
+		result.ConsecutiveFailures = 1
+		if exists {
+			result.ConsecutiveFailures = previous.ConsecutiveFailures + 1
+		}
+	}
+
+	p.latest[result.ID] = result
+	entries := append(p.history[result.ID], result)
+	if len(entries) > p.maxHistory {
+		entries = entries[len(entries)-p.maxHistory:]
+	}
+	p.history[result.ID] = entries
+
+	alerting := p.alerting[result.ID]
+	raise := !result.Success && !alerting && result.ConsecutiveFailures >= p.failureThreshold
+	clear := result.Success && alerting
+	if raise {
+		p.alerting[result.ID] = true
+	}
+	if clear {
+		delete(p.alerting, result.ID)
+	}
+	p.mu.Unlock()
+
+	label := probeLabel(result)
+	event := probeEvent(result)
+	if raise {
+		log.Printf("[HTTPProbe] %s failed %d times in a row: %s", result.URL, result.ConsecutiveFailures, result.Error)
+		event.Type = "critical"
+		event.Message = fmt.Sprintf("HTTP probe %s failed %d times in a row: %s", label, result.ConsecutiveFailures, result.Error)
+		event.Attributes["error"] = result.Error
+		p.eventLog.Record(event)
+	}
+	if clear {
+		event.Type = "success"
+		event.Message = fmt.Sprintf("HTTP probe %s recovered (status %d, %dms)", label, result.StatusCode, result.LatencyMs)
+		p.eventLog.Record(event)
+	}
+
+	return result
+}
+
+// probeLabel 이벤트 메시지용 대상 표시 (URL과 클러스터)
+func probeLabel(result HTTPResult) string {
+	if result.ClusterID == "" {
+		return result.URL
+	}
+	return fmt.Sprintf("%s (%s)", result.URL, result.ClusterID)
+}
+
+// probeEvent 대상별 이벤트 공통 필드
+func probeEvent(result HTTPResult) eventlog.Event {
+	return eventlog.Event{
+		Source:         "http-probe",
+		ClusterID:      result.ClusterID,
+		InvolvedObject: &eventlog.ObjectReference{Kind: "ProbeTarget", Name: result.ID},
+		Attributes:     map[string]string{"url": result.URL, "kind": result.Kind},
+	}
+}
+
+// parseIngressTargets "member1=https://a.example.com/healthz,member2=https://b.example.com/" 형식 파싱
+func parseIngressTargets(value string) []HTTPTarget {
+	targets := make([]HTTPTarget, 0)
+	for _, entry := range splitList(value) {
+		clusterID, rawURL, ok := strings.Cut(entry, "=")
+		if !ok {
+			log.Printf("Warning: invalid HTTP_PROBE_INGRESSES entry %q", entry)
+			continue
+		}
+		targets = append(targets, HTTPTarget{
+			ID:        "ingress/" + clusterID + "/" + rawURL,
+			Kind:      TargetIngress,
+			ClusterID: clusterID,
+			URL:       rawURL,
+		})
+	}
+	return targets
+}
@@ -0,0 +1,273 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	"github.com/minkyulee/pf-dashboard-backend/internal/gslb"
+)
+
+// IsolationSource 의도적으로 비활성화된 엔드포인트 조회 (failover.Controller가 구현)
+// apply 모드가 자동 Failover로 격리된 엔드포인트를 되살리지 않도록 사용
+type IsolationSource interface {
+	IsIsolated(poolID, address string) bool
+}
+
+// Report 한 번의 비교 결과
+type Report struct {
+	Time    time.Time `json:"time"`
+	Source  string    `json:"source"`
+	Apply   bool      `json:"apply"`
+	InSync  bool      `json:"inSync"`
+	Drifts  []Drift   `json:"drifts"`
+	Applied []Drift   `json:"applied"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// Reconciler 선언 파일과 GSLB 공급자 상태를 주기적으로 비교
+type Reconciler struct {
+	provider  gslb.Provider
+	eventLog  *eventlog.EventLog
+	path      string
+	interval  time.Duration
+	apply     bool
+	isolation IsolationSource
+
+	runMu    sync.Mutex // Reconcile 동시 실행 방지
+	mu       sync.RWMutex
+	latest   *Report
+	reported map[string]bool // 이미 이벤트로 보고한 drift
+}
+
+// NewReconcilerFromEnv 환경변수 기반 Reconciler 생성
+func NewReconcilerFromEnv(provider gslb.Provider, eventLog *eventlog.EventLog) *Reconciler {
+	apply, _ := strconv.ParseBool(os.Getenv("GSLB_RECONCILE_APPLY"))
+
+	return &Reconciler{
+		provider: provider,
+		eventLog: eventLog,
+		path:     os.Getenv("GSLB_DESIRED_STATE"),
+		interval: getDurationEnv("GSLB_RECONCILE_INTERVAL", 60*time.Second),
+		apply:    apply,
+		reported: make(map[string]bool),
+	}
+}
+
+// SetIsolationSource 자동 Failover 격리 상태 소스 설정
+func (r *Reconciler) SetIsolationSource(source IsolationSource) {
+	r.isolation = source
+}
+
+// Enabled 선언 파일이 설정되었는지
+func (r *Reconciler) Enabled() bool {
+	return r.path != ""
+}
+
+// Run 주기적으로 비교 (블로킹)
+func (r *Reconciler) Run() {
+	if !r.Enabled() || r.interval <= 0 {
+		log.Printf("[Reconciler] Disabled (desired state: %q, interval: %s)", r.path, r.interval)
+		return
+	}
+
+	log.Printf("[Reconciler] Started (desired state: %s, interval: %s, apply: %v)", r.path, r.interval, r.apply)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	r.Reconcile(context.Background())
+	for range ticker.C {
+		r.Reconcile(context.Background())
+	}
+}
+
+// Latest 마지막 비교 결과 (아직 없으면 nil)
+func (r *Reconciler) Latest() *Report {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.latest
+}
+
+// Reconcile 선언 파일을 다시 읽어 비교하고, apply 모드면 교정 가능한 drift 수정
+func (r *Reconciler) Reconcile(ctx context.Context) *Report {
+	r.runMu.Lock()
+	defer r.runMu.Unlock()
+
+	report := &Report{
+		Time:    time.Now(),
+		Source:  r.path,
+		Apply:   r.apply,
+		Drifts:  []Drift{},
+		Applied: []Drift{},
+	}
+	defer r.store(report)
+
+	desired, err := LoadDesiredState(r.path)
+	if err != nil {
+		log.Printf("[Reconciler] %v", err)
+		report.Error = err.Error()
+		return report
+	}
+
+	actual, err := r.provider.ListGSLBs(ctx)
+	if err != nil {
+		log.Printf("[Reconciler] Failed to list GSLBs: %v", err)
+		report.Error = err.Error()
+		return report
+	}
+
+	drifts := Diff(desired, actual)
+	for i := range drifts {
+		if drifts[i].Fixable && r.isolation != nil && r.isolation.IsIsolated(drifts[i].PoolID, drifts[i].Endpoint) {
+			drifts[i].Fixable = false
+			drifts[i].Note = "isolated by failover controller"
+		}
+	}
+
+	if r.apply {
+		drifts = r.applyDrifts(ctx, desired, drifts, report)
+	}
+
+	report.Drifts = drifts
+	report.InSync = len(drifts) == 0
+	r.reportEvents(drifts)
+	return report
+}
+
+// applyDrifts 엔드포인트 가중치/활성 상태 drift 교정 후 남은 drift 반환
+func (r *Reconciler) applyDrifts(ctx context.Context, desired *DesiredState, drifts []Drift, report *Report) []Drift {
+	remaining := make([]Drift, 0, len(drifts))
+	fixed := make(map[string]bool) // 같은 엔드포인트의 weight/disabled를 한 번에 갱신
+
+	for _, d := range drifts {
+		if !d.Fixable {
+			remaining = append(remaining, d)
+			continue
+		}
+
+		endpointKey := d.PoolID + "/" + d.Endpoint
+		if !fixed[endpointKey] {
+			if err := r.applyEndpoint(ctx, desired, d); err != nil {
+				log.Printf("[Reconciler] Failed to correct %s: %v", d, err)
+				d.Note = "apply failed: " + err.Error()
+				remaining = append(remaining, d)
+				r.eventLog.Record(d.event("critical", fmt.Sprintf("GSLB 설정 drift 교정 실패: %s (%v)", d, err)))
+				continue
+			}
+			fixed[endpointKey] = true
+		}
+
+		report.Applied = append(report.Applied, d)
+		log.Printf("[Reconciler] Corrected %s", d)
+		r.eventLog.Record(d.event("auto", fmt.Sprintf("GSLB 설정 drift 교정: %s", d)))
+	}
+	return remaining
+}
+
+// applyEndpoint 선언된 가중치/활성 상태로 엔드포인트 갱신
+func (r *Reconciler) applyEndpoint(ctx context.Context, desired *DesiredState, d Drift) error {
+	de, ok := desired.desiredEndpoint(d.GSLB, d.Pool, d.Endpoint)
+	if !ok {
+		return fmt.Errorf("endpoint %s is not declared", d.Endpoint)
+	}
+
+	endpoints, err := r.provider.ListEndpoints(ctx, d.PoolID)
+	if err != nil {
+		return err
+	}
+
+	for _, ep := range endpoints {
+		if ep.Address != d.Endpoint {
+			continue
+		}
+		if de.Weight != nil {
+			ep.Weight = *de.Weight
+		}
+		ep.Disabled = de.Disabled
+		return r.provider.UpdateEndpoint(ctx, d.PoolID, ep)
+	}
+	return fmt.Errorf("endpoint %s not found in pool %s", d.Endpoint, d.PoolID)
+}
+
+// reportEvents 새로 발견된 drift와 동기화 복귀를 이벤트로 기록
+func (r *Reconciler) reportEvents(drifts []Drift) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := make(map[string]bool, len(drifts))
+	for _, d := range drifts {
+		current[d.Key()] = true
+		if !r.reported[d.Key()] {
+			r.eventLog.Record(d.event("info", fmt.Sprintf("GSLB 설정 drift 감지: %s", d)))
+		}
+	}
+
+	if len(drifts) == 0 && len(r.reported) > 0 {
+		r.eventLog.Record(eventlog.Event{Type: "success", Message: "GSLB 설정이 선언 파일과 일치합니다", Source: "gslb-reconciler"})
+	}
+	r.reported = current
+}
+
+// event drift 이벤트 생성
+func (d Drift) event(eventType, message string) eventlog.Event {
+	ref := &eventlog.ObjectReference{Kind: "GSLB", Name: d.GSLB}
+	switch d.Kind {
+	case KindPool:
+		ref = &eventlog.ObjectReference{Kind: "GSLBPool", Name: d.Pool}
+	case KindEndpoint:
+		ref = &eventlog.ObjectReference{Kind: "GSLBEndpoint", Name: d.Endpoint}
+	}
+
+	// 감지된 drift는 warning, 교정은 info, 교정 실패는 critical
+	severity := eventlog.SeverityWarning
+	switch eventType {
+	case "auto":
+		severity = eventlog.SeverityInfo
+	case "critical":
+		severity = eventlog.SeverityCritical
+	}
+
+	return eventlog.Event{
+		Type:           eventType,
+		Severity:       severity,
+		Message:        message,
+		Source:         "gslb-reconciler",
+		InvolvedObject: ref,
+		Attributes: map[string]string{
+			"gslb":    d.GSLB,
+			"field":   d.Field,
+			"desired": d.Desired,
+			"actual":  d.Actual,
+		},
+	}
+}
+
+// store 마지막 비교 결과 저장
+func (r *Reconciler) store(report *Report) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.latest = report
+}
+
+// getDurationEnv 기간 환경변수 조회
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Warning: invalid %s %q, using %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return d
+}
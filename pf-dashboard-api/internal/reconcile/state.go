@@ -0,0 +1,275 @@
+package reconcile
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/minkyulee/pf-dashboard-backend/internal/gslb"
+	"sigs.k8s.io/yaml"
+)
+
+// DesiredState git으로 관리하는 GSLB 선언적 설정
+// 공급자가 부여하는 ID 대신 GSLB 이름, 풀 이름, 엔드포인트 주소로 매칭
+//
+//	gslbs:
+//	  - name: karmada
+//	    domain: karmada.example.gslb.com
+//	    ttl: 30
+//	    routingRule: FAILOVER
+//	    pools:
+//	      - name: member1
+//	        order: 1
+//	        regionContent: KR
+//	        endpoints:
+//	          - address: 10.0.0.1
+//	            weight: 1
+type DesiredState struct {
+	GSLBs []DesiredGSLB `json:"gslbs"`
+}
+
+// DesiredGSLB GSLB 선언 (비어 있거나 0인 필드는 비교하지 않음)
+type DesiredGSLB struct {
+	Name        string        `json:"name"`
+	Domain      string        `json:"domain,omitempty"`
+	TTL         int           `json:"ttl,omitempty"`
+	RoutingRule string        `json:"routingRule,omitempty"`
+	Disabled    bool          `json:"disabled,omitempty"`
+	Pools       []DesiredPool `json:"pools"`
+}
+
+// DesiredPool GSLB에 연결될 풀 선언
+type DesiredPool struct {
+	Name          string            `json:"name"`
+	Order         int               `json:"order,omitempty"`
+	RegionContent string            `json:"regionContent,omitempty"`
+	Disabled      bool              `json:"disabled,omitempty"`
+	Endpoints     []DesiredEndpoint `json:"endpoints"`
+}
+
+// DesiredEndpoint 엔드포인트 선언 (weight를 생략하면 비교하지 않음)
+type DesiredEndpoint struct {
+	Address  string   `json:"address"`
+	Weight   *float64 `json:"weight,omitempty"`
+	Disabled bool     `json:"disabled,omitempty"`
+}
+
+// Drift 종류
+const (
+	KindGSLB     = "gslb"
+	KindPool     = "pool"
+	KindEndpoint = "endpoint"
+)
+
+// Drift 선언과 실제 상태의 차이 하나
+type Drift struct {
+	Kind     string `json:"kind"` // gslb, pool, endpoint
+	GSLB     string `json:"gslb"`
+	Pool     string `json:"pool,omitempty"`
+	PoolID   string `json:"poolId,omitempty"`
+	Endpoint string `json:"endpoint,omitempty"`
+	Field    string `json:"field"` // missing, unexpected 또는 필드 이름
+	Desired  string `json:"desired"`
+	Actual   string `json:"actual"`
+	// Fixable apply 모드에서 자동 교정 가능한지 (엔드포인트 가중치/활성 상태만 가능)
+	Fixable bool   `json:"fixable"`
+	Note    string `json:"note,omitempty"`
+}
+
+// Key drift 식별자 (같은 차이의 반복 보고 방지용)
+func (d Drift) Key() string {
+	return strings.Join([]string{d.Kind, d.GSLB, d.Pool, d.Endpoint, d.Field, d.Desired, d.Actual}, "|")
+}
+
+// String 이벤트 메시지용 표현
+func (d Drift) String() string {
+	target := d.GSLB
+	if d.Pool != "" {
+		target += "/" + d.Pool
+	}
+	if d.Endpoint != "" {
+		target += "/" + d.Endpoint
+	}
+
+	switch d.Field {
+	case "missing":
+		return fmt.Sprintf("%s %s is declared but missing", d.Kind, target)
+	case "unexpected":
+		return fmt.Sprintf("%s %s exists but is not declared", d.Kind, target)
+	default:
+		return fmt.Sprintf("%s %s: desired %s, actual %s", target, d.Field, d.Desired, d.Actual)
+	}
+}
+
+// LoadDesiredState YAML(또는 JSON) 선언 파일 읽기
+func LoadDesiredState(path string) (*DesiredState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read desired state: %w", err)
+	}
+
+	var state DesiredState
+	if err := yaml.UnmarshalStrict(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse desired state: %w", err)
+	}
+
+	for _, g := range state.GSLBs {
+		if g.Name == "" {
+			return nil, fmt.Errorf("desired state has a GSLB without name")
+		}
+		for _, p := range g.Pools {
+			if p.Name == "" {
+				return nil, fmt.Errorf("GSLB %s has a pool without name", g.Name)
+			}
+		}
+	}
+	return &state, nil
+}
+
+// Diff 선언된 GSLB와 공급자에서 읽은 GSLB 비교
+// 선언에 없는 GSLB는 관리 대상이 아니므로 무시하고, 선언된 GSLB 안의 선언되지 않은 풀/엔드포인트는 보고
+func Diff(desired *DesiredState, actual []gslb.GSLB) []Drift {
+	actualByName := make(map[string]gslb.GSLB, len(actual))
+	for _, g := range actual {
+		actualByName[g.Name] = g
+	}
+
+	drifts := make([]Drift, 0)
+	for _, dg := range desired.GSLBs {
+		ag, exists := actualByName[dg.Name]
+		if !exists {
+			drifts = append(drifts, Drift{Kind: KindGSLB, GSLB: dg.Name, Field: "missing", Desired: "present", Actual: "absent"})
+			continue
+		}
+
+		gslbDrift := func(field, desiredValue, actualValue string) {
+			drifts = append(drifts, Drift{Kind: KindGSLB, GSLB: dg.Name, Field: field, Desired: desiredValue, Actual: actualValue})
+		}
+		if dg.Domain != "" && !strings.EqualFold(strings.TrimSuffix(dg.Domain, "."), strings.TrimSuffix(ag.Domain, ".")) {
+			gslbDrift("domain", dg.Domain, ag.Domain)
+		}
+		if dg.TTL != 0 && dg.TTL != ag.TTL {
+			gslbDrift("ttl", strconv.Itoa(dg.TTL), strconv.Itoa(ag.TTL))
+		}
+		if dg.RoutingRule != "" && !strings.EqualFold(dg.RoutingRule, ag.RoutingRule) {
+			gslbDrift("routingRule", dg.RoutingRule, ag.RoutingRule)
+		}
+		if dg.Disabled != ag.Disabled {
+			gslbDrift("disabled", strconv.FormatBool(dg.Disabled), strconv.FormatBool(ag.Disabled))
+		}
+
+		drifts = append(drifts, diffPools(dg, ag)...)
+	}
+
+	sort.SliceStable(drifts, func(i, j int) bool { return drifts[i].Key() < drifts[j].Key() })
+	return drifts
+}
+
+// diffPools GSLB에 연결된 풀과 엔드포인트 비교
+func diffPools(dg DesiredGSLB, ag gslb.GSLB) []Drift {
+	actualPools := make(map[string]gslb.ConnectedPool, len(ag.Pools))
+	for _, cp := range ag.Pools {
+		actualPools[cp.Pool.Name] = cp
+	}
+
+	drifts := make([]Drift, 0)
+	declared := make(map[string]bool, len(dg.Pools))
+	for _, dp := range dg.Pools {
+		declared[dp.Name] = true
+
+		cp, exists := actualPools[dp.Name]
+		if !exists {
+			drifts = append(drifts, Drift{Kind: KindPool, GSLB: dg.Name, Pool: dp.Name, Field: "missing", Desired: "connected", Actual: "absent"})
+			continue
+		}
+
+		poolDrift := func(field, desiredValue, actualValue string) {
+			drifts = append(drifts, Drift{Kind: KindPool, GSLB: dg.Name, Pool: dp.Name, PoolID: cp.Pool.ID,
+				Field: field, Desired: desiredValue, Actual: actualValue})
+		}
+		if dp.Order != 0 && dp.Order != cp.Order {
+			poolDrift("order", strconv.Itoa(dp.Order), strconv.Itoa(cp.Order))
+		}
+		if dp.RegionContent != "" && !strings.EqualFold(dp.RegionContent, cp.RegionContent) {
+			poolDrift("regionContent", dp.RegionContent, cp.RegionContent)
+		}
+		if dp.Disabled != cp.Pool.Disabled {
+			poolDrift("disabled", strconv.FormatBool(dp.Disabled), strconv.FormatBool(cp.Pool.Disabled))
+		}
+
+		drifts = append(drifts, diffEndpoints(dg.Name, dp, cp.Pool)...)
+	}
+
+	for _, cp := range ag.Pools {
+		if !declared[cp.Pool.Name] {
+			drifts = append(drifts, Drift{Kind: KindPool, GSLB: dg.Name, Pool: cp.Pool.Name, PoolID: cp.Pool.ID,
+				Field: "unexpected", Desired: "absent", Actual: "connected"})
+		}
+	}
+	return drifts
+}
+
+// diffEndpoints 풀 안의 엔드포인트 비교 (가중치/활성 상태는 apply 모드로 교정 가능)
+func diffEndpoints(gslbName string, dp DesiredPool, pool gslb.Pool) []Drift {
+	actualEndpoints := make(map[string]gslb.Endpoint, len(pool.Endpoints))
+	for _, ep := range pool.Endpoints {
+		actualEndpoints[ep.Address] = ep
+	}
+
+	drifts := make([]Drift, 0)
+	declared := make(map[string]bool, len(dp.Endpoints))
+	for _, de := range dp.Endpoints {
+		declared[de.Address] = true
+
+		ep, exists := actualEndpoints[de.Address]
+		if !exists {
+			drifts = append(drifts, Drift{Kind: KindEndpoint, GSLB: gslbName, Pool: dp.Name, PoolID: pool.ID, Endpoint: de.Address,
+				Field: "missing", Desired: "present", Actual: "absent"})
+			continue
+		}
+
+		if de.Weight != nil && *de.Weight != ep.Weight {
+			drifts = append(drifts, Drift{Kind: KindEndpoint, GSLB: gslbName, Pool: dp.Name, PoolID: pool.ID, Endpoint: de.Address,
+				Field: "weight", Desired: formatWeight(*de.Weight), Actual: formatWeight(ep.Weight), Fixable: true})
+		}
+		if de.Disabled != ep.Disabled {
+			drifts = append(drifts, Drift{Kind: KindEndpoint, GSLB: gslbName, Pool: dp.Name, PoolID: pool.ID, Endpoint: de.Address,
+				Field: "disabled", Desired: strconv.FormatBool(de.Disabled), Actual: strconv.FormatBool(ep.Disabled), Fixable: true})
+		}
+	}
+
+	for _, ep := range pool.Endpoints {
+		if !declared[ep.Address] {
+			drifts = append(drifts, Drift{Kind: KindEndpoint, GSLB: gslbName, Pool: dp.Name, PoolID: pool.ID, Endpoint: ep.Address,
+				Field: "unexpected", Desired: "absent", Actual: "present"})
+		}
+	}
+	return drifts
+}
+
+// desiredEndpoint 선언에서 엔드포인트 찾기
+func (s *DesiredState) desiredEndpoint(gslbName, poolName, address string) (DesiredEndpoint, bool) {
+	for _, g := range s.GSLBs {
+		if g.Name != gslbName {
+			continue
+		}
+		for _, p := range g.Pools {
+			if p.Name != poolName {
+				continue
+			}
+			for _, ep := range p.Endpoints {
+				if ep.Address == address {
+					return ep, true
+				}
+			}
+		}
+	}
+	return DesiredEndpoint{}, false
+}
+
+// formatWeight 가중치 문자열 표현
+func formatWeight(weight float64) string {
+	return strconv.FormatFloat(weight, 'f', -1, 64)
+}
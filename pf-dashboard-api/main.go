@@ -7,10 +7,17 @@ import (
 	"time"
 
 	"github.com/joho/godotenv"
+	"github.com/minkyulee/pf-dashboard-backend/internal/alertmanager"
 	"github.com/minkyulee/pf-dashboard-backend/internal/eventlog"
+	"github.com/minkyulee/pf-dashboard-backend/internal/failover"
 	"github.com/minkyulee/pf-dashboard-backend/internal/gslb"
 	"github.com/minkyulee/pf-dashboard-backend/internal/handlers"
+	"github.com/minkyulee/pf-dashboard-backend/internal/incident"
+	"github.com/minkyulee/pf-dashboard-backend/internal/karmada"
 	"github.com/minkyulee/pf-dashboard-backend/internal/monitor"
+	"github.com/minkyulee/pf-dashboard-backend/internal/notify"
+	"github.com/minkyulee/pf-dashboard-backend/internal/probe"
+	"github.com/minkyulee/pf-dashboard-backend/internal/reconcile"
 	"github.com/rs/cors"
 )
 
@@ -26,21 +33,78 @@ func main() {
 		port = "8080"
 	}
 
-	// In-Memory 이벤트 로그 시스템 초기화
-	eventLog := eventlog.NewEventLog(100) // 최근 100개 이벤트 저장
+	// 이벤트 로그 초기화 (EVENT_STORE: bolt(기본값), memory)
+	// WebSocket 초기 전송에는 최근 100개 이벤트 사용
+	// 반복 이벤트는 횟수로 합치고 flapping 리소스의 상태 전환은 억제
+	eventStore, eventRetention := eventlog.NewStoreFromEnv()
+	eventLog := eventlog.NewEventLogWithStore(eventStore, eventRetention, 100)
+	eventLog.SetAggregation(eventlog.AggregationFromEnv())
+	go eventLog.RunRetention(time.Minute)
+
+	// 인시던트 관리 (critical 이벤트로 열고 관련 이벤트를 묶어 복구 시 종료)
+	// 이벤트를 놓치지 않도록 다른 컴포넌트보다 먼저 구독
+	incidentManager := incident.NewManagerFromEnv()
+	go incidentManager.Run(eventLog.WatchBuffered(256))
+
+	// 외부 알림 (NOTIFY_CONFIG 또는 NOTIFY_WEBHOOK_URL/NOTIFY_SLACK_URL/NOTIFY_MATTERMOST_URL)
+	notifier := notify.NewNotifierFromEnv()
+	go notifier.Run(eventLog.WatchBuffered(256))
+
+	// Alertmanager 연동 (ALERTMANAGER_URL로 critical 이벤트 전송, webhook으로 알림 수신)
+	alertPusher := alertmanager.NewPusherFromEnv()
+	go alertPusher.Run(eventLog.WatchBuffered(256))
+	alertReceiver := alertmanager.NewReceiverFromEnv(eventLog)
 
 	// 멀티 클러스터 모니터링 시스템 초기화
 	multiClusterMonitor := monitor.NewMultiClusterMonitor(eventLog)
 
-	// GSLB 클라이언트 초기화
-	gslbClient := gslb.NewGSLBClient()
-	
-	// GSLB 설정 확인
-	if os.Getenv("GSLB_APP_KEY") != "" {
-		log.Printf("GSLB API configured successfully")
-	} else {
-		log.Printf("Warning: GSLB_APP_KEY not set - GSLB features will be disabled")
-	}
+	// member 클러스터 Kubernetes Event 전달 (KUBE_EVENT_NAMESPACES, KUBE_EVENT_REASONS)
+	kubeEventForwarder := monitor.NewKubeEventForwarderFromEnv(multiClusterMonitor, eventLog)
+	go kubeEventForwarder.Run()
+
+	// member 클러스터 Deployment 롤아웃 추적 (ROLLOUT_NAMESPACES)
+	rolloutTracker := monitor.NewRolloutTrackerFromEnv(multiClusterMonitor, eventLog)
+	go rolloutTracker.Run()
+
+	// member 클러스터 간 워크로드 drift 비교 (WORKLOAD_DRIFT_NAMESPACES, WORKLOAD_DRIFT_INTERVAL)
+	workloadDrift := monitor.NewWorkloadDriftDetectorFromEnv(multiClusterMonitor, eventLog)
+	go workloadDrift.Run()
+
+	// Karmada 전파 상태 조회 (KARMADA_CONTEXT, 결과는 트래픽 그래프 노드에 반영)
+	karmadaClient := karmada.NewClientFromEnv()
+	propagationView := karmada.NewPropagationViewFromEnv(karmadaClient)
+	multiClusterMonitor.SetPlacementSource(propagationView)
+	go propagationView.Run()
+
+	// member 클러스터 장애 대비 준비 상태 평가 (FAILOVER_READINESS_NAMESPACES, Karmada placement 반영)
+	failoverReadiness := monitor.NewFailoverReadinessFromEnv(multiClusterMonitor)
+
+	// Karmada 컨트롤 플레인 상태 확인 (API 서버, 컴포넌트 Pod, Cluster 객체)
+	controlPlaneMonitor := karmada.NewControlPlaneMonitorFromEnv(karmadaClient, eventLog)
+	go controlPlaneMonitor.Run()
+
+	// GSLB 공급자 초기화 (GSLB_PROVIDER: nhn, route53, file)
+	gslbProvider := gslb.NewProviderFromEnv()
+	log.Printf("GSLB provider configured: %s", gslbProvider.Name())
+
+	// GSLB 자동 Failover 컨트롤러 (FAILOVER_MODE: off, dry-run, enforce)
+	failoverController := failover.NewControllerFromEnv(gslbProvider, eventLog)
+	go failoverController.Run(multiClusterMonitor.Watch())
+
+	// GSLB 선언 파일(GSLB_DESIRED_STATE)과 실제 상태 비교 (GSLB_RECONCILE_APPLY=true면 교정)
+	reconciler := reconcile.NewReconcilerFromEnv(gslbProvider, eventLog)
+	reconciler.SetIsolationSource(failoverController)
+	go reconciler.Run()
+
+	// GSLB 도메인 DNS 해석 프로브 (DNS_PROBE_INTERVAL=0이면 비활성)
+	dnsProbe := probe.NewDNSProbeFromEnv(gslbProvider, eventLog)
+	go dnsProbe.Run()
+
+	// GSLB 엔드포인트/클러스터 Ingress 합성 HTTP 프로브 (결과는 클러스터 헬스에 반영)
+	httpProbe := probe.NewHTTPProbeFromEnv(gslbProvider, eventLog)
+	httpProbe.SetIsolationSource(failoverController)
+	multiClusterMonitor.SetProbeHealthSource(httpProbe)
+	go httpProbe.Run()
 
 	// 초기 이벤트 로그
 	eventLog.AddEvent("info", "시스템 정상. Member1/Member2 클러스터에 트래픽 분산 중.")
@@ -63,7 +127,7 @@ func main() {
 	mux := http.NewServeMux()
 
 	// WebSocket 엔드포인트
-	wsHandler := handlers.NewWebSocketHandler(multiClusterMonitor, eventLog)
+	wsHandler := handlers.NewWebSocketHandler(multiClusterMonitor, eventLog, incidentManager, controlPlaneMonitor)
 	mux.HandleFunc("/ws", wsHandler.HandleWebSocket)
 
 	// 트래픽 그래프 API 엔드포인트
@@ -71,10 +135,70 @@ func main() {
 	mux.HandleFunc("/api/traffic/graph", trafficHandler.HandleServiceGraph)
 
 	// GSLB API 엔드포인트
-	gslbHandler := handlers.NewGSLBHandler(gslbClient)
+	gslbHandler := handlers.NewGSLBHandler(gslbProvider)
 	mux.HandleFunc("/api/gslb/pools", gslbHandler.HandleGSLBPools)
 	mux.HandleFunc("/api/gslb/details", gslbHandler.HandleGSLBDetails)
 	mux.HandleFunc("/api/gslb/info", gslbHandler.HandleGSLBByName)
+	mux.HandleFunc("/api/gslb/health", gslbHandler.HandleGSLBHealth)
+	mux.HandleFunc("/api/gslb/simulate", gslbHandler.HandleGSLBSimulate)
+
+	dnsProbeHandler := handlers.NewDNSProbeHandler(dnsProbe)
+	mux.HandleFunc("/api/gslb/dns", dnsProbeHandler.HandleHistory)
+
+	driftHandler := handlers.NewDriftHandler(reconciler)
+	mux.HandleFunc("/api/gslb/drift", driftHandler.HandleDrift)
+
+	// 합성 HTTP 프로브 API 엔드포인트
+	httpProbeHandler := handlers.NewHTTPProbeHandler(httpProbe)
+	mux.HandleFunc("/api/probes", httpProbeHandler.HandleProbes)
+
+	// 클러스터, 노드, Pod 조회 API 엔드포인트
+	clustersHandler := handlers.NewClustersHandler(multiClusterMonitor)
+	mux.HandleFunc("/api/clusters", clustersHandler.HandleClusters)
+	mux.HandleFunc("/api/clusters/", clustersHandler.HandleClusterResource)
+
+	// 이벤트 조회/입력 API 엔드포인트 (입력 토큰은 테스트 알림 인증에도 사용)
+	eventIngester := eventlog.NewIngesterFromEnv(eventLog)
+	eventsHandler := handlers.NewEventsHandler(eventLog, eventIngester)
+	mux.HandleFunc("/api/events", eventsHandler.HandleEvents)
+
+	// Deployment 롤아웃 API 엔드포인트
+	rolloutsHandler := handlers.NewRolloutsHandler(rolloutTracker)
+	mux.HandleFunc("/api/rollouts", rolloutsHandler.HandleRollouts)
+
+	// 클러스터 간 워크로드 drift API 엔드포인트
+	workloadDriftHandler := handlers.NewWorkloadDriftHandler(workloadDrift)
+	mux.HandleFunc("/api/workloads/drift", workloadDriftHandler.HandleWorkloadDrift)
+
+	// Karmada 전파 상태, 컨트롤 플레인 상태 API 엔드포인트
+	karmadaHandler := handlers.NewKarmadaHandler(propagationView, controlPlaneMonitor)
+	mux.HandleFunc("/api/karmada/propagation", karmadaHandler.HandlePropagation)
+	mux.HandleFunc("/api/karmada/health", karmadaHandler.HandleHealth)
+
+	// 장애 대비 준비 상태 API 엔드포인트
+	failoverReadinessHandler := handlers.NewFailoverReadinessHandler(failoverReadiness)
+	mux.HandleFunc("/api/failover/readiness", failoverReadinessHandler.HandleReadiness)
+
+	// 인시던트 API 엔드포인트
+	incidentsHandler := handlers.NewIncidentsHandler(incidentManager)
+	mux.HandleFunc("/api/incidents", incidentsHandler.HandleIncidents)
+	mux.HandleFunc("/api/incidents/detail", incidentsHandler.HandleIncident)
+	mux.HandleFunc("/api/incidents/ack", incidentsHandler.HandleAcknowledge)
+	mux.HandleFunc("/api/incidents/annotate", incidentsHandler.HandleAnnotate)
+
+	// 알림 API 엔드포인트
+	notifyHandler := handlers.NewNotifyHandler(notifier, eventIngester)
+	mux.HandleFunc("/api/notifications/status", notifyHandler.HandleStatus)
+	mux.HandleFunc("/api/notifications/test", notifyHandler.HandleTest)
+
+	// Alertmanager API 엔드포인트
+	alertmanagerHandler := handlers.NewAlertmanagerHandler(alertPusher, alertReceiver)
+	mux.HandleFunc("/api/alertmanager/webhook", alertmanagerHandler.HandleWebhook)
+	mux.HandleFunc("/api/alertmanager/status", alertmanagerHandler.HandleStatus)
+
+	// 자동 Failover 상태 API 엔드포인트
+	failoverHandler := handlers.NewFailoverHandler(failoverController)
+	mux.HandleFunc("/api/failover/status", failoverHandler.HandleStatus)
 
 	// 헬스 체크 엔드포인트
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
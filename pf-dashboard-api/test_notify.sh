@@ -0,0 +1,51 @@
+#!/bin/bash
+
+# 로컬 HTTP 수신기로 알림 전송 테스트
+# 서버를 다음 환경변수로 실행한 뒤 사용:
+#   NOTIFY_WEBHOOK_URL=http://localhost:9099/hook NOTIFY_WEBHOOK_SECRET=test-secret EVENT_INGEST_TOKENS=test=<token>
+# 테스트 알림 인증 토큰은 EVENT_INGEST_TOKEN으로 지정
+
+RECEIVER_PORT=${RECEIVER_PORT:-9099}
+API_URL=${API_URL:-http://localhost:8080}
+export NOTIFY_WEBHOOK_SECRET=${NOTIFY_WEBHOOK_SECRET:-test-secret}
+
+# 요청을 출력하고 X-PF-Signature를 검증하는 수신기
+python3 - "$RECEIVER_PORT" <<'PY' &
+import hashlib, hmac, json, os, sys
+from http.server import BaseHTTPRequestHandler, HTTPServer
+
+secret = os.environ.get("NOTIFY_WEBHOOK_SECRET", "").encode()
+
+class Handler(BaseHTTPRequestHandler):
+    def do_POST(self):
+        body = self.rfile.read(int(self.headers.get("Content-Length", 0)))
+        timestamp = self.headers.get("X-PF-Timestamp", "")
+        signature = self.headers.get("X-PF-Signature", "")
+        expected = "sha256=" + hmac.new(secret, timestamp.encode() + b"." + body, hashlib.sha256).hexdigest()
+        print("=== %s ===" % self.path)
+        print("signature: %s" % ("valid" if hmac.compare_digest(signature, expected) else "INVALID"))
+        print(json.dumps(json.loads(body), indent=2, ensure_ascii=False))
+        sys.stdout.flush()
+        self.send_response(200)
+        self.end_headers()
+
+    def log_message(self, *args):
+        pass
+
+HTTPServer(("", int(sys.argv[1])), Handler).serve_forever()
+PY
+RECEIVER_PID=$!
+trap "kill $RECEIVER_PID" EXIT
+sleep 1
+
+echo "=== Notifier status ==="
+curl -s "${API_URL}/api/notifications/status" | jq '.' || echo "Backend not running or error"
+
+echo ""
+echo "=== Sending test notification ==="
+curl -s -X POST "${API_URL}/api/notifications/test" \
+  -H "Authorization: Bearer ${EVENT_INGEST_TOKEN}" \
+  -H "Content-Type: application/json" \
+  -d '{"receiver": "webhook", "message": "PF Dashboard test notification"}' | jq '.'
+
+sleep 1
@@ -2,6 +2,7 @@ import { useState, useEffect } from 'react';
 import GlobalStatus from './components/GlobalStatus';
 import TrafficFlow from './components/TrafficFlow';
 import ClusterCard from './components/ClusterCard';
+import ControlPlaneCard from './components/ControlPlaneCard';
 import EventLog from './components/EventLog';
 import TrafficTopology from './components/TrafficTopology';
 import websocketService from './services/websocket';
@@ -32,6 +33,9 @@ function App() {
     }
   ]);
 
+  // Karmada 컨트롤 플레인 상태 (백엔드가 Karmada API 서버에 연결된 경우에만 수신)
+  const [controlPlane, setControlPlane] = useState(null);
+
   // 이벤트 로그 관리
   const [events, setEvents] = useState([]);
 
@@ -56,6 +60,11 @@ function App() {
       console.log('[App.jsx] setClusters called with:', data);
     };
 
+    // 컨트롤 플레인 상태 리스너
+    const handleControlPlaneUpdate = (data) => {
+      setControlPlane(data);
+    };
+
     // 초기 이벤트 로드 리스너
     const handleEventsLoad = (data) => {
       console.log('Events loaded:', data);
@@ -63,9 +72,18 @@ function App() {
     };
 
     // 새 이벤트 리스너
+    // 같은 id가 다시 오면 집계(횟수/마지막 발생 시각)가 갱신된 이벤트이므로 교체
     const handleNewEvent = (data) => {
       console.log('New event:', data);
-      setEvents((prevEvents) => [...prevEvents, data]);
+      setEvents((prevEvents) => {
+        const index = data.id ? prevEvents.findIndex((event) => event.id === data.id) : -1;
+        if (index === -1) {
+          return [...prevEvents, data];
+        }
+        const nextEvents = [...prevEvents];
+        nextEvents[index] = data;
+        return nextEvents;
+      });
     };
 
     // 연결 상태 리스너
@@ -81,6 +99,7 @@ function App() {
 
     // 리스너 등록
     websocketService.on('clusters', handleClustersUpdate);
+    websocketService.on('controlPlane', handleControlPlaneUpdate);
     websocketService.on('events', handleEventsLoad);
     websocketService.on('event', handleNewEvent);
     websocketService.on('connected', handleConnected);
@@ -92,6 +111,7 @@ function App() {
     // 클린업
     return () => {
       websocketService.off('clusters', handleClustersUpdate);
+      websocketService.off('controlPlane', handleControlPlaneUpdate);
       websocketService.off('events', handleEventsLoad);
       websocketService.off('event', handleNewEvent);
       websocketService.off('connected', handleConnected);
@@ -115,6 +135,7 @@ function App() {
 
         {/* 클러스터 카드 섹션 */}
         <div className="grid grid-cols-1 md:grid-cols-2 gap-6 mb-8">
+          {controlPlane && <ControlPlaneCard controlPlane={controlPlane} />}
           {clusters.map((cluster) => (
             <ClusterCard key={cluster.id} cluster={cluster} />
           ))}
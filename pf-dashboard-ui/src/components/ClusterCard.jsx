@@ -43,7 +43,15 @@ const ClusterCard = ({ cluster }) => {
             {/* 상태 아이콘 */}
             <div className={`w-4 h-4 rounded-full ${isFailure ? 'bg-red-500' : 'bg-green-500'} shadow-lg`}></div>
           </div>
-          <div className="flex items-center space-x-2 text-sm text-gray-600">
+          <div className="flex items-center space-x-3 text-sm text-gray-600">
+            {cluster.utilization && cluster.utilization.nodes > 0 && (
+              <span title={cluster.utilization.usage ? 'metrics.k8s.io usage / allocatable' : 'pod requests / allocatable'}>
+                CPU <span className="font-semibold">{cluster.utilization.cpuUsagePercent ?? cluster.utilization.cpuRequestPercent}%</span>
+                {' · '}
+                Mem <span className="font-semibold">{cluster.utilization.memoryUsagePercent ?? cluster.utilization.memoryRequestPercent}%</span>
+                {!cluster.utilization.usage && <span className="text-xs text-gray-400"> (req)</span>}
+              </span>
+            )}
             <span>Pods: <span className="font-semibold">{cluster.pods}</span></span>
           </div>
         </div>
@@ -0,0 +1,94 @@
+import React from 'react';
+
+const statusStyles = {
+  ready: 'bg-green-100 text-green-800',
+  degraded: 'bg-yellow-100 text-yellow-800',
+  down: 'bg-red-100 text-red-800',
+  failure: 'bg-red-100 text-red-800',
+  unknown: 'bg-gray-100 text-gray-600',
+};
+
+/**
+ * ControlPlaneCard 컴포넌트
+ * Karmada 컨트롤 플레인(API 서버, 컴포넌트, Cluster 객체) 상태를 member 클러스터 카드와 나란히 표시
+ */
+const ControlPlaneCard = ({ controlPlane }) => {
+  const isFailure = controlPlane.status === 'failure';
+  const isDegraded = controlPlane.status === 'degraded';
+  const borderColor = isFailure ? 'border-red-500' : isDegraded ? 'border-yellow-500' : 'border-green-500';
+  const dotColor = isFailure ? 'bg-red-500' : isDegraded ? 'bg-yellow-500' : 'bg-green-500';
+
+  return (
+    <div className={`md:col-span-2 bg-white/80 backdrop-blur-xl ${borderColor} border-2 rounded-2xl p-6 shadow-lg transition-all duration-500`}>
+      {/* 헤더 */}
+      <div className="flex items-center justify-between mb-4">
+        <div className="flex items-center space-x-3">
+          <h3 className="text-2xl font-semibold text-gray-900">{controlPlane.name}</h3>
+          <div className={`w-4 h-4 rounded-full ${dotColor} shadow-lg`}></div>
+        </div>
+        <div className="text-sm text-gray-600">
+          API server:{' '}
+          <span className={`font-semibold ${controlPlane.apiServer?.ready ? 'text-green-600' : 'text-red-600'}`}>
+            {controlPlane.apiServer?.ready ? 'Ready' : 'Not Ready'}
+          </span>
+        </div>
+      </div>
+
+      {controlPlane.reason && (
+        <div className="mb-4 p-3 bg-orange-50 border border-orange-200 rounded-xl text-sm text-orange-800">
+          {controlPlane.reason}
+        </div>
+      )}
+
+      <div className="grid grid-cols-1 md:grid-cols-2 gap-4">
+        {/* 컴포넌트 */}
+        <div>
+          <div className="font-semibold text-gray-700 mb-2">Components</div>
+          {(controlPlane.components || []).length === 0 ? (
+            <div className="text-sm text-gray-400">Component pods are not monitored</div>
+          ) : (
+            <ul className="space-y-1">
+              {controlPlane.components.map((c) => (
+                <li key={c.name} className="flex items-center justify-between text-sm" title={c.message || undefined}>
+                  <span className="text-gray-800">{c.name}</span>
+                  <span className={`px-2 py-0.5 rounded text-xs font-medium ${statusStyles[c.status] || statusStyles.unknown}`}>
+                    {c.status} {c.total > 0 && `${c.ready}/${c.total}`}
+                  </span>
+                </li>
+              ))}
+            </ul>
+          )}
+        </div>
+
+        {/* Cluster 객체 */}
+        <div>
+          <div className="font-semibold text-gray-700 mb-2">Member Clusters</div>
+          <ul className="space-y-1">
+            {(controlPlane.clusters || []).map((c) => (
+              <li key={c.name} className="text-sm" title={c.message || undefined}>
+                <div className="flex items-center justify-between">
+                  <span className="text-gray-800">
+                    {c.name} <span className="text-xs text-gray-500">({c.syncMode})</span>
+                  </span>
+                  <span
+                    className={`px-2 py-0.5 rounded text-xs font-medium ${
+                      c.ready === 'True' && !c.leaseExpired ? statusStyles.ready : statusStyles.failure
+                    }`}
+                  >
+                    {c.ready === 'True' ? 'Ready' : c.reason || 'NotReady'}
+                    {c.leaseExpired && ' · lease expired'}
+                  </span>
+                </div>
+                {c.taints && c.taints.length > 0 && (
+                  <div className="text-xs text-gray-500">{c.taints.join(', ')}</div>
+                )}
+              </li>
+            ))}
+          </ul>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default ControlPlaneCard;
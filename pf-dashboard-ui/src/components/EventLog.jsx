@@ -36,7 +36,7 @@ const EventLog = ({ events }) => {
         ) : (
           events.map((event, index) => (
             <div
-              key={index}
+              key={event.id ?? index}
               className="flex items-start gap-3 py-2 border-b border-gray-100 last:border-0"
             >
               <span className="text-gray-400 text-xs font-mono mt-0.5 w-16 flex-shrink-0">
@@ -48,6 +48,14 @@ const EventLog = ({ events }) => {
               <p className="text-gray-700 text-sm flex-1">
                 {event.message}
               </p>
+              {event.count > 1 && (
+                <span
+                  className="text-gray-500 text-xs font-mono mt-0.5 flex-shrink-0"
+                  title={`first ${event.firstSeen} / last ${event.lastSeen}`}
+                >
+                  ×{event.count}
+                </span>
+              )}
             </div>
           ))
         )}
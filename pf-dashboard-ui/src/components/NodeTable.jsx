@@ -1,5 +1,80 @@
 import React from 'react';
 
+/**
+ * CPU 밀리코어 표시 (1000m 이상은 코어 단위)
+ */
+function formatCPU(millis) {
+  if (millis === undefined || millis === null) return '-';
+  return millis >= 1000 ? `${(millis / 1000).toFixed(1)}` : `${millis}m`;
+}
+
+/**
+ * 바이트를 Mi/Gi 단위로 표시
+ */
+function formatBytes(bytes) {
+  if (bytes === undefined || bytes === null) return '-';
+  const gi = bytes / (1024 * 1024 * 1024);
+  return gi >= 1 ? `${gi.toFixed(1)}Gi` : `${Math.round(bytes / (1024 * 1024))}Mi`;
+}
+
+/**
+ * allocatable 대비 비율 (allocatable이 없으면 null)
+ */
+function percent(value, total) {
+  if (!total) return null;
+  return Math.round((value / total) * 100);
+}
+
+/**
+ * 사용률 막대 색상
+ */
+function barColor(value) {
+  if (value >= 90) return 'bg-red-500';
+  if (value >= 75) return 'bg-orange-400';
+  return 'bg-green-500';
+}
+
+/**
+ * 리소스 셀: 실측 사용량(metrics-server가 있을 때)과 요청/제한, allocatable
+ */
+function ResourceCell({ usage, requests, limits, allocatable, format }) {
+  const primary = usage ?? requests;
+  const ratio = percent(primary, allocatable);
+
+  return (
+    <div className="min-w-[9rem]">
+      <div className="flex items-center justify-between text-xs text-gray-700">
+        <span>
+          {usage !== undefined && usage !== null ? format(usage) : format(requests)} / {format(allocatable)}
+        </span>
+        {ratio !== null && <span className="font-medium">{ratio}%</span>}
+      </div>
+      {ratio !== null && (
+        <div className="mt-1 h-1.5 w-full bg-gray-200 rounded">
+          <div className={`h-1.5 rounded ${barColor(ratio)}`} style={{ width: `${Math.min(ratio, 100)}%` }}></div>
+        </div>
+      )}
+      <div className="mt-0.5 text-[10px] text-gray-400">
+        {usage !== undefined && usage !== null ? `req ${format(requests)} · ` : 'requests · '}
+        lim {format(limits)}
+      </div>
+    </div>
+  );
+}
+
+/**
+ * NotReady 원인 툴팁 (조건 reason/message, 다운 시간)
+ */
+function notReadyTitle(node) {
+  const cause = node.notReady;
+  if (!cause) return undefined;
+  let text = cause.reason;
+  if (cause.message) text += `: ${cause.message}`;
+  if (cause.conditions?.length) text += `\n${cause.conditions.join('\n')}`;
+  if (cause.downFor) text += `\ndown for ${cause.downFor}`;
+  return text;
+}
+
 /**
  * NodeTable 컴포넌트
  * 클러스터의 노드 상세 정보를 테이블 형태로 표시
@@ -27,6 +102,24 @@ function NodeTable({ nodes }) {
             <th className="px-3 py-2 text-left text-xs font-medium text-gray-500 uppercase tracking-wider">
               Roles
             </th>
+            <th className="px-3 py-2 text-left text-xs font-medium text-gray-500 uppercase tracking-wider">
+              Zone
+            </th>
+            <th className="px-3 py-2 text-left text-xs font-medium text-gray-500 uppercase tracking-wider">
+              Taints
+            </th>
+            <th className="px-3 py-2 text-left text-xs font-medium text-gray-500 uppercase tracking-wider">
+              CPU
+            </th>
+            <th className="px-3 py-2 text-left text-xs font-medium text-gray-500 uppercase tracking-wider">
+              Memory
+            </th>
+            <th className="px-3 py-2 text-left text-xs font-medium text-gray-500 uppercase tracking-wider">
+              Pods
+            </th>
+            <th className="px-3 py-2 text-left text-xs font-medium text-gray-500 uppercase tracking-wider">
+              Ephemeral
+            </th>
             <th className="px-3 py-2 text-left text-xs font-medium text-gray-500 uppercase tracking-wider">
               Age
             </th>
@@ -60,13 +153,56 @@ function NodeTable({ nodes }) {
                       ? 'bg-green-100 text-green-800'
                       : 'bg-red-100 text-red-800'
                   }`}
+                  title={notReadyTitle(node)}
                 >
                   {node.status}
+                  {node.notReady?.downFor && ` (${node.notReady.downFor})`}
                 </span>
+                {node.unschedulable && (
+                  <span className="ml-1 inline-flex items-center px-2 py-0.5 rounded text-xs font-medium bg-yellow-100 text-yellow-800">
+                    SchedulingDisabled
+                  </span>
+                )}
+                {node.notReady && (
+                  <div className="mt-0.5 text-[10px] text-red-600">{node.notReady.reason}</div>
+                )}
               </td>
               <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
                 {node.roles}
               </td>
+              <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
+                {node.zone || node.region ? [node.region, node.zone].filter(Boolean).join(' / ') : '-'}
+              </td>
+              <td
+                className="px-3 py-2 whitespace-nowrap text-sm text-gray-700"
+                title={(node.taints || []).map((t) => `${t.key}${t.value ? `=${t.value}` : ''}:${t.effect}`).join('\n') || undefined}
+              >
+                {node.taints?.length || 0}
+              </td>
+              <td className="px-3 py-2 whitespace-nowrap text-sm">
+                <ResourceCell
+                  usage={node.usage?.cpuMillis}
+                  requests={node.requests?.cpuMillis}
+                  limits={node.limits?.cpuMillis}
+                  allocatable={node.allocatable?.cpuMillis}
+                  format={formatCPU}
+                />
+              </td>
+              <td className="px-3 py-2 whitespace-nowrap text-sm">
+                <ResourceCell
+                  usage={node.usage?.memoryBytes}
+                  requests={node.requests?.memoryBytes}
+                  limits={node.limits?.memoryBytes}
+                  allocatable={node.allocatable?.memoryBytes}
+                  format={formatBytes}
+                />
+              </td>
+              <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
+                {node.requests?.pods ?? '-'} / {node.allocatable?.pods ?? '-'}
+              </td>
+              <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
+                {formatBytes(node.allocatable?.ephemeralStorageBytes)}
+              </td>
               <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
                 {node.age}
               </td>
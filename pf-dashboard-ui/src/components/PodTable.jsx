@@ -1,5 +1,23 @@
 import React from 'react';
 
+/**
+ * 문제가 있는 컨테이너 상태 요약 (waiting/terminated 원인, 직전 종료 코드)
+ */
+function containerIssues(pod) {
+  return (pod.containers || [])
+    .filter((c) => !c.ready && c.state !== 'running' && !(c.init && c.state === 'terminated' && c.exitCode === 0))
+    .map((c) => {
+      let text = `${c.init ? 'init ' : ''}${c.name}: ${c.reason || c.state}`;
+      if (c.exitCode !== undefined && c.exitCode !== null) {
+        text += ` (exit ${c.exitCode})`;
+      }
+      if (c.lastTermination) {
+        text += ` · last exit ${c.lastTermination.exitCode} ${c.lastTermination.reason}`;
+      }
+      return text;
+    });
+}
+
 /**
  * PodTable 컴포넌트
  * 클러스터의 Pod 상세 정보를 테이블 형태로 표시
@@ -42,41 +60,56 @@ function PodTable({ pods }) {
           </tr>
         </thead>
         <tbody className="bg-white divide-y divide-gray-200">
-          {pods.map((pod, index) => (
-            <tr key={index} className="hover:bg-gray-50">
-              <td className="px-3 py-2 whitespace-nowrap text-sm font-medium text-gray-900">
-                {pod.name}
-              </td>
-              <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
-                {pod.ready}
-              </td>
-              <td className="px-3 py-2 whitespace-nowrap text-sm">
-                <span
-                  className={`inline-flex items-center px-2 py-0.5 rounded text-xs font-medium ${
-                    pod.status === 'Running'
-                      ? 'bg-green-100 text-green-800'
-                      : pod.status === 'Pending'
-                      ? 'bg-yellow-100 text-yellow-800'
-                      : 'bg-red-100 text-red-800'
-                  }`}
-                >
-                  {pod.status}
-                </span>
-              </td>
-              <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
-                {pod.restarts}
-              </td>
-              <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
-                {pod.age}
-              </td>
-              <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
-                {pod.ip}
-              </td>
-              <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
-                {pod.node}
-              </td>
-            </tr>
-          ))}
+          {pods.map((pod, index) => {
+            const status = pod.reason || pod.status;
+            const issues = containerIssues(pod);
+            return (
+              <tr key={index} className="hover:bg-gray-50">
+                <td className="px-3 py-2 whitespace-nowrap text-sm font-medium text-gray-900">
+                  {pod.name}
+                  {issues.map((issue) => (
+                    <div key={issue} className="text-xs font-normal text-red-600">
+                      {issue}
+                    </div>
+                  ))}
+                  {pod.message && (
+                    <div className="text-xs font-normal text-gray-500 whitespace-normal max-w-md">
+                      {pod.message}
+                    </div>
+                  )}
+                </td>
+                <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
+                  {pod.ready}
+                </td>
+                <td className="px-3 py-2 whitespace-nowrap text-sm">
+                  <span
+                    title={pod.message || undefined}
+                    className={`inline-flex items-center px-2 py-0.5 rounded text-xs font-medium ${
+                      status === 'Running'
+                        ? 'bg-green-100 text-green-800'
+                        : status === 'Pending' || status === 'ContainerCreating' || status.startsWith('Init:')
+                        ? 'bg-yellow-100 text-yellow-800'
+                        : 'bg-red-100 text-red-800'
+                    }`}
+                  >
+                    {status}
+                  </span>
+                </td>
+                <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
+                  {pod.restarts}
+                </td>
+                <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
+                  {pod.age}
+                </td>
+                <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
+                  {pod.ip}
+                </td>
+                <td className="px-3 py-2 whitespace-nowrap text-sm text-gray-700">
+                  {pod.node}
+                </td>
+              </tr>
+            );
+          })}
         </tbody>
       </table>
     </div>
@@ -222,40 +222,40 @@ const TrafficFlow = ({ clusters }) => {
             {activeTab === 'gslb' && gslbInfo && (
               <div>
                 <div className="text-lg font-bold mb-4" style={{ color: '#000000' }}>
-                  🌐 {gslbInfo.gslbName}
+                  🌐 {gslbInfo.name}
                 </div>
                 <div className="space-y-3 text-sm">
                   <div>
                     <div className="font-bold text-gray-700">Domain</div>
                     <div className="text-gray-900 break-all bg-gray-50 p-2 rounded mt-1">
-                      {gslbInfo.gslbDomain}
+                      {gslbInfo.domain}
                     </div>
                   </div>
                   <div>
                     <div className="font-bold text-gray-700">Routing Rule</div>
                     <div className="text-gray-900 bg-gray-50 p-2 rounded mt-1">
-                      {gslbInfo.gslbRoutingRule}
+                      {gslbInfo.routingRule}
                     </div>
                   </div>
                   <div className="grid grid-cols-2 gap-3">
                     <div>
                       <div className="font-bold text-gray-700">TTL</div>
                       <div className="text-gray-900 bg-gray-50 p-2 rounded mt-1">
-                        {gslbInfo.gslbTtl}s
+                        {gslbInfo.ttl}s
                       </div>
                     </div>
                     <div>
                       <div className="font-bold text-gray-700">Pools</div>
                       <div className="text-gray-900 bg-gray-50 p-2 rounded mt-1">
-                        {gslbInfo.connectedPoolList?.length || 0}
+                        {gslbInfo.pools?.length || 0}
                       </div>
                     </div>
                   </div>
                   <div className="pt-3 border-t border-gray-200">
                     <span className={`text-sm font-bold px-3 py-1 rounded ${
-                      gslbInfo.gslbDisabled ? 'bg-red-100 text-red-600' : 'bg-green-100 text-green-600'
+                      gslbInfo.disabled ? 'bg-red-100 text-red-600' : 'bg-green-100 text-green-600'
                     }`}>
-                      {gslbInfo.gslbDisabled ? '✗ DISABLED' : '✓ ENABLED'}
+                      {gslbInfo.disabled ? '✗ DISABLED' : '✓ ENABLED'}
                     </span>
                   </div>
                 </div>
@@ -269,22 +269,22 @@ const TrafficFlow = ({ clusters }) => {
                   📍 Member1 Cluster
                 </div>
                 <div className="space-y-4">
-                  {gslbInfo.connectedPoolList?.map((connectedPool, index) => (
+                  {gslbInfo.pools?.map((connectedPool, index) => (
                     <div key={index} className="border border-gray-200 rounded-lg p-4">
-                      <div className="font-bold text-gray-900 mb-3">🌐 {connectedPool.pool.poolName}</div>
-                      {connectedPool.pool.endpointList?.map((endpoint, epIndex) => (
+                      <div className="font-bold text-gray-900 mb-3">🌐 {connectedPool.pool.name}</div>
+                      {connectedPool.pool.endpoints?.map((endpoint, epIndex) => (
                         <div key={epIndex} className="mb-3 pb-3 border-b border-gray-100 last:border-0 last:pb-0">
                           <div className="font-mono text-xs break-all p-2 rounded bg-blue-50 text-gray-900 font-semibold mb-2">
-                            {endpoint.endpointAddress}
+                            {endpoint.address}
                           </div>
                           <div className="flex items-center justify-between text-xs">
                             <span className={`font-bold px-2 py-1 rounded ${
-                              endpoint.endpointDisabled ? 'bg-gray-100 text-gray-600' : 'bg-green-100 text-green-600'
+                              endpoint.disabled ? 'bg-gray-100 text-gray-600' : 'bg-green-100 text-green-600'
                             }`}>
-                              {endpoint.endpointDisabled ? '✗ DISABLED' : '✓ ENABLED'}
+                              {endpoint.disabled ? '✗ DISABLED' : '✓ ENABLED'}
                             </span>
                             <span className="text-gray-700 font-semibold">
-                              Weight: {endpoint.endpointWeight}
+                              Weight: {endpoint.weight}
                             </span>
                           </div>
                         </div>
@@ -302,22 +302,22 @@ const TrafficFlow = ({ clusters }) => {
                   📍 Member2 Cluster
                 </div>
                 <div className="space-y-4">
-                  {gslbInfo.connectedPoolList?.map((connectedPool, index) => (
+                  {gslbInfo.pools?.map((connectedPool, index) => (
                     <div key={index} className="border border-gray-200 rounded-lg p-4">
-                      <div className="font-bold text-gray-900 mb-3">🌐 {connectedPool.pool.poolName}</div>
-                      {connectedPool.pool.endpointList?.map((endpoint, epIndex) => (
+                      <div className="font-bold text-gray-900 mb-3">🌐 {connectedPool.pool.name}</div>
+                      {connectedPool.pool.endpoints?.map((endpoint, epIndex) => (
                         <div key={epIndex} className="mb-3 pb-3 border-b border-gray-100 last:border-0 last:pb-0">
                           <div className="font-mono text-xs break-all p-2 rounded bg-blue-50 text-gray-900 font-semibold mb-2">
-                            {endpoint.endpointAddress}
+                            {endpoint.address}
                           </div>
                           <div className="flex items-center justify-between text-xs">
                             <span className={`font-bold px-2 py-1 rounded ${
-                              endpoint.endpointDisabled ? 'bg-gray-100 text-gray-600' : 'bg-green-100 text-green-600'
+                              endpoint.disabled ? 'bg-gray-100 text-gray-600' : 'bg-green-100 text-green-600'
                             }`}>
-                              {endpoint.endpointDisabled ? '✗ DISABLED' : '✓ ENABLED'}
+                              {endpoint.disabled ? '✗ DISABLED' : '✓ ENABLED'}
                             </span>
                             <span className="text-gray-700 font-semibold">
-                              Weight: {endpoint.endpointWeight}
+                              Weight: {endpoint.weight}
                             </span>
                           </div>
                         </div>
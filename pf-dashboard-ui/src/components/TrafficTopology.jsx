@@ -11,6 +11,31 @@ import ReactFlow, {
 import dagre from 'dagre';
 import 'reactflow/dist/style.css';
 
+/**
+ * Karmada 전파 정보 툴팁 (정책, replica 분배, 적용 상태, override)
+ */
+function placementTitle(node) {
+  const placement = node.placement;
+  if (!placement) {
+    return undefined;
+  }
+
+  const lines = [`Policy: ${placement.policy || '-'}`];
+  if (placement.replicaScheduling) {
+    lines.push(`Scheduling: ${placement.replicaScheduling}`);
+  }
+  (placement.clusters || []).forEach((c) => {
+    lines.push(`${c.name}: ${c.replicas} replicas, ${c.applied ? 'applied' : 'not applied'}${c.health ? `, ${c.health}` : ''}`);
+  });
+  if (placement.overrides && placement.overrides.length > 0) {
+    lines.push(`Overrides: ${placement.overrides.join(', ')}`);
+  }
+  if (!placement.fullyApplied) {
+    lines.push(placement.scheduled ? 'Not fully applied' : 'Not scheduled');
+  }
+  return lines.join('\n');
+}
+
 /**
  * TrafficTopology 컴포넌트
  * React Flow를 사용한 Federation 토폴로지 시각화
@@ -401,7 +426,7 @@ function TrafficTopology({ deploymentName = 'frontend', namespace = 'tf-monitor'
         };
         nodeData = {
           label: (
-            <div className="text-left">
+            <div className="text-left" title={placementTitle(node)}>
               <div className="font-semibold text-xs text-gray-800">{node.name}</div>
               <div className={`text-xs font-bold mt-0.5 ${isHealthy ? 'text-green-600' : 'text-orange-500'}`}>
                 {node.readyReplicas}/{node.replicas} replicas
@@ -446,7 +471,7 @@ function TrafficTopology({ deploymentName = 'frontend', namespace = 'tf-monitor'
         
         nodeData = {
           label: (
-            <div className="text-left">
+            <div className="text-left" title={placementTitle(node)}>
               <div className="font-semibold text-xs text-gray-800">{node.name}</div>
               <div className={`text-xs font-bold mt-0.5 ${isHealthy ? 'text-green-600' : 'text-orange-500'}`}>
                 {node.readyReplicas}/{node.replicas} replicas
@@ -579,7 +604,7 @@ function TrafficTopology({ deploymentName = 'frontend', namespace = 'tf-monitor'
         };
         nodeData = {
           label: (
-            <div className="text-left">
+            <div className="text-left" title={placementTitle(node)}>
               <div className="font-semibold text-xs text-gray-800">{node.name}</div>
               <div className={`text-xs font-bold mt-0.5 ${isHealthy ? 'text-green-600' : 'text-orange-500'}`}>
                 {node.readyReplicas}/{node.replicas} replicas
@@ -624,7 +649,7 @@ function TrafficTopology({ deploymentName = 'frontend', namespace = 'tf-monitor'
         
         nodeData = {
           label: (
-            <div className="text-left">
+            <div className="text-left" title={placementTitle(node)}>
               <div className="font-semibold text-xs text-gray-800">{node.name}</div>
               <div className={`text-xs font-bold mt-0.5 ${isHealthy ? 'text-green-600' : 'text-orange-500'}`}>
                 {node.readyReplicas}/{node.replicas} replicas
@@ -9,6 +9,7 @@ class WebSocketService {
     this.reconnectDelay = 3000; // 3초 후 재연결
     this.listeners = {
       clusters: [],
+      controlPlane: [],
       events: [],
       event: [],
       connected: [],
@@ -99,7 +100,7 @@ class WebSocketService {
 
   /**
    * 이벤트 리스너 등록
-   * @param {string} type - 이벤트 타입 (clusters, events, event, connected, disconnected)
+   * @param {string} type - 이벤트 타입 (clusters, controlPlane, events, event, connected, disconnected)
    * @param {Function} callback - 콜백 함수
    */
   on(type, callback) {